@@ -0,0 +1,1327 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func appServiceSiteConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"always_on": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"default_documents": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"dotnet_framework_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "v4.0",
+					ValidateFunc: validation.StringInSlice([]string{
+						"v2.0",
+						"v4.0",
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+
+				"java_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"1.7",
+						"1.8",
+					}, false),
+				},
+
+				"java_container": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"JETTY",
+						"TOMCAT",
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+
+				"java_container_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"php_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"5.5",
+						"5.6",
+						"7.0",
+						"7.1",
+					}, false),
+				},
+
+				"python_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"2.7",
+						"3.4",
+					}, false),
+				},
+
+				"use_32_bit_worker_process": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func appServiceAppSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+	}
+}
+
+func appServiceConnectionStringSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"value": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.APIHub),
+						string(web.Custom),
+						string(web.DocDb),
+						string(web.EventHub),
+						string(web.MySQL),
+						string(web.NotificationHub),
+						string(web.PostgreSQL),
+						string(web.RedisCache),
+						string(web.ServiceBus),
+						string(web.SQLAzure),
+						string(web.SQLServer),
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+			},
+		},
+	}
+}
+
+func expandAppServiceSiteConfig(d *schema.ResourceData) web.SiteConfig {
+	configs := d.Get("site_config").([]interface{})
+	siteConfig := web.SiteConfig{}
+
+	if len(configs) == 0 {
+		return siteConfig
+	}
+
+	config := configs[0].(map[string]interface{})
+
+	if v, ok := config["always_on"]; ok {
+		siteConfig.AlwaysOn = utils.Bool(v.(bool))
+	}
+
+	if v, ok := config["default_documents"]; ok {
+		input := v.([]interface{})
+
+		documents := make([]string, 0)
+		for _, document := range input {
+			documents = append(documents, document.(string))
+		}
+
+		siteConfig.DefaultDocuments = &documents
+	}
+
+	if v, ok := config["dotnet_framework_version"]; ok {
+		siteConfig.NetFrameworkVersion = utils.String(v.(string))
+	}
+
+	if v, ok := config["java_version"]; ok {
+		siteConfig.JavaVersion = utils.String(v.(string))
+	}
+
+	if v, ok := config["java_container"]; ok {
+		siteConfig.JavaContainer = utils.String(v.(string))
+	}
+
+	if v, ok := config["java_container_version"]; ok {
+		siteConfig.JavaContainerVersion = utils.String(v.(string))
+	}
+
+	if v, ok := config["php_version"]; ok {
+		siteConfig.PhpVersion = utils.String(v.(string))
+	}
+
+	if v, ok := config["python_version"]; ok {
+		siteConfig.PythonVersion = utils.String(v.(string))
+	}
+
+	if v, ok := config["use_32_bit_worker_process"]; ok {
+		siteConfig.Use32BitWorkerProcess = utils.Bool(v.(bool))
+	}
+
+	return siteConfig
+}
+
+func flattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
+	results := make([]interface{}, 0)
+	result := make(map[string]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	if input.AlwaysOn != nil {
+		result["always_on"] = *input.AlwaysOn
+	}
+
+	documents := make([]string, 0)
+	if s := input.DefaultDocuments; s != nil {
+		documents = *s
+	}
+	result["default_documents"] = documents
+
+	if input.NetFrameworkVersion != nil {
+		result["dotnet_framework_version"] = *input.NetFrameworkVersion
+	}
+
+	if input.JavaVersion != nil {
+		result["java_version"] = *input.JavaVersion
+	}
+
+	if input.JavaContainer != nil {
+		result["java_container"] = *input.JavaContainer
+	}
+
+	if input.JavaContainerVersion != nil {
+		result["java_container_version"] = *input.JavaContainerVersion
+	}
+
+	if input.PhpVersion != nil {
+		result["php_version"] = *input.PhpVersion
+	}
+
+	if input.PythonVersion != nil {
+		result["python_version"] = *input.PythonVersion
+	}
+
+	if input.Use32BitWorkerProcess != nil {
+		result["use_32_bit_worker_process"] = *input.Use32BitWorkerProcess
+	}
+
+	return append(results, result)
+}
+
+func expandAppServiceAppSettings(d *schema.ResourceData) *map[string]*string {
+	input := d.Get("app_settings").(map[string]interface{})
+	output := make(map[string]*string, len(input))
+
+	for k, v := range input {
+		output[k] = utils.String(v.(string))
+	}
+
+	return &output
+}
+
+func flattenAppServiceAppSettings(input *map[string]*string) map[string]string {
+	output := make(map[string]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = *v
+	}
+
+	return output
+}
+
+func expandAppServiceConnectionStrings(d *schema.ResourceData) *map[string]*web.ConnStringValueTypePair {
+	input := d.Get("connection_string").(*schema.Set).List()
+	output := make(map[string]*web.ConnStringValueTypePair, len(input))
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		csName := vals["name"].(string)
+		csType := vals["type"].(string)
+		csValue := vals["value"].(string)
+
+		output[csName] = &web.ConnStringValueTypePair{
+			Value: utils.String(csValue),
+			Type:  web.ConnectionStringType(csType),
+		}
+	}
+
+	return &output
+}
+
+func flattenAppServiceConnectionStrings(input *map[string]*web.ConnStringValueTypePair) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for k, v := range *input {
+		result := make(map[string]interface{}, 0)
+		result["name"] = k
+		result["type"] = string(v.Type)
+		if v.Value != nil {
+			result["value"] = *v.Value
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func appServiceStickySettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"app_setting_names": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"connection_string_names": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandAppServiceStickySettings(d *schema.ResourceData) *web.SlotConfigNames {
+	appSettingNames := make([]string, 0)
+	connectionStringNames := make([]string, 0)
+
+	settings := d.Get("sticky_settings").([]interface{})
+	if len(settings) > 0 && settings[0] != nil {
+		config := settings[0].(map[string]interface{})
+
+		for _, v := range config["app_setting_names"].([]interface{}) {
+			appSettingNames = append(appSettingNames, v.(string))
+		}
+
+		for _, v := range config["connection_string_names"].([]interface{}) {
+			connectionStringNames = append(connectionStringNames, v.(string))
+		}
+	}
+
+	return &web.SlotConfigNames{
+		AppSettingNames:       &appSettingNames,
+		ConnectionStringNames: &connectionStringNames,
+	}
+}
+
+func flattenAppServiceStickySettings(input *web.SlotConfigNames) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	appSettingNames := make([]interface{}, 0)
+	if input.AppSettingNames != nil {
+		for _, v := range *input.AppSettingNames {
+			appSettingNames = append(appSettingNames, v)
+		}
+	}
+
+	connectionStringNames := make([]interface{}, 0)
+	if input.ConnectionStringNames != nil {
+		for _, v := range *input.ConnectionStringNames {
+			connectionStringNames = append(connectionStringNames, v)
+		}
+	}
+
+	if len(appSettingNames) == 0 && len(connectionStringNames) == 0 {
+		return results
+	}
+
+	result := map[string]interface{}{
+		"app_setting_names":       appSettingNames,
+		"connection_string_names": connectionStringNames,
+	}
+	return append(results, result)
+}
+
+func appServiceAuthSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"runtime_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				"unauthenticated_client_action": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AllowAnonymous),
+						string(web.RedirectToLoginPage),
+					}, false),
+				},
+
+				"default_provider": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AzureActiveDirectory),
+						string(web.Facebook),
+						string(web.Google),
+						string(web.MicrosoftAccount),
+						string(web.Twitter),
+					}, false),
+				},
+
+				"token_store_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"token_refresh_extension_hours": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  72,
+				},
+
+				"issuer": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"additional_login_params": {
+					Type:     schema.TypeMap,
+					Optional: true,
+				},
+
+				"active_directory": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"client_secret": {
+								Type:      schema.TypeString,
+								Optional:  true,
+								Sensitive: true,
+							},
+
+							"allowed_audiences": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"facebook": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"app_secret": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+
+							"oauth_scopes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"google": appServiceAuthSettingsOAuthProviderSchema(),
+
+				"microsoft": appServiceAuthSettingsOAuthProviderSchema(),
+
+				"twitter": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"consumer_key": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"consumer_secret": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func appServiceAuthSettingsOAuthProviderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"client_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"client_secret": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+
+				"oauth_scopes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandAppServiceAuthSettings(d *schema.ResourceData) web.SiteAuthSettings {
+	// when the block is removed Authentication is disabled, which clears the providers
+	props := web.SiteAuthSettingsProperties{
+		Enabled: utils.Bool(false),
+	}
+
+	settings := d.Get("auth_settings").([]interface{})
+	if len(settings) > 0 && settings[0] != nil {
+		config := settings[0].(map[string]interface{})
+
+		props.Enabled = utils.Bool(config["enabled"].(bool))
+		props.TokenStoreEnabled = utils.Bool(config["token_store_enabled"].(bool))
+		props.TokenRefreshExtensionHours = utils.Float(config["token_refresh_extension_hours"].(float64))
+		props.AllowedExternalRedirectUrls = expandAppServiceStringList(config["allowed_external_redirect_urls"].([]interface{}))
+		props.AdditionalLoginParams = expandAppServiceAuthSettingsLoginParams(config["additional_login_params"].(map[string]interface{}))
+
+		if v := config["runtime_version"].(string); v != "" {
+			props.RuntimeVersion = utils.String(v)
+		}
+
+		if v := config["unauthenticated_client_action"].(string); v != "" {
+			props.UnauthenticatedClientAction = web.UnauthenticatedClientAction(v)
+		}
+
+		if v := config["default_provider"].(string); v != "" {
+			props.DefaultProvider = web.BuiltInAuthenticationProvider(v)
+		}
+
+		if v := config["issuer"].(string); v != "" {
+			props.Issuer = utils.String(v)
+		}
+
+		if v := config["active_directory"].([]interface{}); len(v) > 0 && v[0] != nil {
+			provider := v[0].(map[string]interface{})
+			props.ClientID = utils.String(provider["client_id"].(string))
+			props.AllowedAudiences = expandAppServiceStringList(provider["allowed_audiences"].([]interface{}))
+
+			if secret := provider["client_secret"].(string); secret != "" {
+				props.ClientSecret = utils.String(secret)
+			}
+		}
+
+		if v := config["facebook"].([]interface{}); len(v) > 0 && v[0] != nil {
+			provider := v[0].(map[string]interface{})
+			props.FacebookAppID = utils.String(provider["app_id"].(string))
+			props.FacebookAppSecret = utils.String(provider["app_secret"].(string))
+			props.FacebookOAuthScopes = expandAppServiceStringList(provider["oauth_scopes"].([]interface{}))
+		}
+
+		if v := config["google"].([]interface{}); len(v) > 0 && v[0] != nil {
+			provider := v[0].(map[string]interface{})
+			props.GoogleClientID = utils.String(provider["client_id"].(string))
+			props.GoogleClientSecret = utils.String(provider["client_secret"].(string))
+			props.GoogleOAuthScopes = expandAppServiceStringList(provider["oauth_scopes"].([]interface{}))
+		}
+
+		if v := config["microsoft"].([]interface{}); len(v) > 0 && v[0] != nil {
+			provider := v[0].(map[string]interface{})
+			props.MicrosoftAccountClientID = utils.String(provider["client_id"].(string))
+			props.MicrosoftAccountClientSecret = utils.String(provider["client_secret"].(string))
+			props.MicrosoftAccountOAuthScopes = expandAppServiceStringList(provider["oauth_scopes"].([]interface{}))
+		}
+
+		if v := config["twitter"].([]interface{}); len(v) > 0 && v[0] != nil {
+			provider := v[0].(map[string]interface{})
+			props.TwitterConsumerKey = utils.String(provider["consumer_key"].(string))
+			props.TwitterConsumerSecret = utils.String(provider["consumer_secret"].(string))
+		}
+	}
+
+	return web.SiteAuthSettings{
+		SiteAuthSettingsProperties: &props,
+	}
+}
+
+func flattenAppServiceAuthSettings(input *web.SiteAuthSettingsProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	enabled := input.Enabled != nil && *input.Enabled
+	hasProvider := input.ClientID != nil || input.FacebookAppID != nil || input.GoogleClientID != nil ||
+		input.MicrosoftAccountClientID != nil || input.TwitterConsumerKey != nil
+
+	// Authentication is disabled (with no providers) by default - which is omitted to avoid a diff
+	if !enabled && !hasProvider {
+		return results
+	}
+
+	result := map[string]interface{}{
+		"enabled":                        enabled,
+		"unauthenticated_client_action":  string(input.UnauthenticatedClientAction),
+		"default_provider":               string(input.DefaultProvider),
+		"allowed_external_redirect_urls": flattenAppServiceStringList(input.AllowedExternalRedirectUrls),
+		"additional_login_params":        flattenAppServiceAuthSettingsLoginParams(input.AdditionalLoginParams),
+	}
+
+	if input.RuntimeVersion != nil {
+		result["runtime_version"] = *input.RuntimeVersion
+	}
+
+	if input.TokenStoreEnabled != nil {
+		result["token_store_enabled"] = *input.TokenStoreEnabled
+	}
+
+	if input.TokenRefreshExtensionHours != nil {
+		result["token_refresh_extension_hours"] = *input.TokenRefreshExtensionHours
+	}
+
+	if input.Issuer != nil {
+		result["issuer"] = *input.Issuer
+	}
+
+	activeDirectory := make([]interface{}, 0)
+	if input.ClientID != nil {
+		provider := map[string]interface{}{
+			"client_id":         *input.ClientID,
+			"allowed_audiences": flattenAppServiceStringList(input.AllowedAudiences),
+		}
+		if input.ClientSecret != nil {
+			provider["client_secret"] = *input.ClientSecret
+		}
+		activeDirectory = append(activeDirectory, provider)
+	}
+	result["active_directory"] = activeDirectory
+
+	facebook := make([]interface{}, 0)
+	if input.FacebookAppID != nil {
+		provider := map[string]interface{}{
+			"app_id":       *input.FacebookAppID,
+			"oauth_scopes": flattenAppServiceStringList(input.FacebookOAuthScopes),
+		}
+		if input.FacebookAppSecret != nil {
+			provider["app_secret"] = *input.FacebookAppSecret
+		}
+		facebook = append(facebook, provider)
+	}
+	result["facebook"] = facebook
+
+	google := make([]interface{}, 0)
+	if input.GoogleClientID != nil {
+		provider := map[string]interface{}{
+			"client_id":    *input.GoogleClientID,
+			"oauth_scopes": flattenAppServiceStringList(input.GoogleOAuthScopes),
+		}
+		if input.GoogleClientSecret != nil {
+			provider["client_secret"] = *input.GoogleClientSecret
+		}
+		google = append(google, provider)
+	}
+	result["google"] = google
+
+	microsoft := make([]interface{}, 0)
+	if input.MicrosoftAccountClientID != nil {
+		provider := map[string]interface{}{
+			"client_id":    *input.MicrosoftAccountClientID,
+			"oauth_scopes": flattenAppServiceStringList(input.MicrosoftAccountOAuthScopes),
+		}
+		if input.MicrosoftAccountClientSecret != nil {
+			provider["client_secret"] = *input.MicrosoftAccountClientSecret
+		}
+		microsoft = append(microsoft, provider)
+	}
+	result["microsoft"] = microsoft
+
+	twitter := make([]interface{}, 0)
+	if input.TwitterConsumerKey != nil {
+		provider := map[string]interface{}{
+			"consumer_key": *input.TwitterConsumerKey,
+		}
+		if input.TwitterConsumerSecret != nil {
+			provider["consumer_secret"] = *input.TwitterConsumerSecret
+		}
+		twitter = append(twitter, provider)
+	}
+	result["twitter"] = twitter
+
+	return append(results, result)
+}
+
+// the API represents Additional Login Params as a list of `key=value` strings
+func expandAppServiceAuthSettingsLoginParams(input map[string]interface{}) *[]string {
+	output := make([]string, 0, len(input))
+	for k, v := range input {
+		output = append(output, fmt.Sprintf("%s=%s", k, v.(string)))
+	}
+	sort.Strings(output)
+	return &output
+}
+
+func flattenAppServiceAuthSettingsLoginParams(input *[]string) map[string]interface{} {
+	output := make(map[string]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		output[parts[0]] = parts[1]
+	}
+
+	return output
+}
+
+func expandAppServiceStringList(input []interface{}) *[]string {
+	output := make([]string, 0, len(input))
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return &output
+}
+
+func flattenAppServiceStringList(input *[]string) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, v)
+	}
+	return output
+}
+
+func appServiceBackupSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"storage_account_url": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+
+				"schedule": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"frequency_interval": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 1000),
+							},
+
+							"frequency_unit": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(web.Day),
+									string(web.Hour),
+								}, false),
+							},
+
+							"keep_at_least_one_backup": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"retention_period_in_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      30,
+								ValidateFunc: validation.IntBetween(0, 9999999),
+							},
+
+							"start_time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validateRFC3339Date,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandAppServiceBackup(d *schema.ResourceData) (*web.BackupRequest, error) {
+	backups := d.Get("backup").([]interface{})
+	if len(backups) == 0 || backups[0] == nil {
+		return nil, nil
+	}
+
+	config := backups[0].(map[string]interface{})
+
+	props := web.BackupRequestProperties{
+		BackupRequestName: utils.String(config["name"].(string)),
+		Enabled:           utils.Bool(config["enabled"].(bool)),
+		StorageAccountURL: utils.String(config["storage_account_url"].(string)),
+	}
+
+	schedules := config["schedule"].([]interface{})
+	if len(schedules) > 0 && schedules[0] != nil {
+		schedule := schedules[0].(map[string]interface{})
+
+		backupSchedule := web.BackupSchedule{
+			FrequencyInterval:     utils.Int32(int32(schedule["frequency_interval"].(int))),
+			FrequencyUnit:         web.FrequencyUnit(schedule["frequency_unit"].(string)),
+			KeepAtLeastOneBackup:  utils.Bool(schedule["keep_at_least_one_backup"].(bool)),
+			RetentionPeriodInDays: utils.Int32(int32(schedule["retention_period_in_days"].(int))),
+		}
+
+		if v := schedule["start_time"].(string); v != "" {
+			startTime, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing `start_time` %q: %+v", v, err)
+			}
+			backupSchedule.StartTime = &date.Time{Time: startTime}
+		}
+
+		props.BackupSchedule = &backupSchedule
+	}
+
+	return &web.BackupRequest{
+		BackupRequestProperties: &props,
+	}, nil
+}
+
+func flattenAppServiceBackup(input *web.BackupRequestProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if input.BackupRequestName != nil {
+		result["name"] = *input.BackupRequestName
+	}
+
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+
+	if input.StorageAccountURL != nil {
+		result["storage_account_url"] = *input.StorageAccountURL
+	}
+
+	schedules := make([]interface{}, 0)
+	if schedule := input.BackupSchedule; schedule != nil {
+		output := make(map[string]interface{}, 0)
+
+		if schedule.FrequencyInterval != nil {
+			output["frequency_interval"] = int(*schedule.FrequencyInterval)
+		}
+
+		output["frequency_unit"] = string(schedule.FrequencyUnit)
+
+		if schedule.KeepAtLeastOneBackup != nil {
+			output["keep_at_least_one_backup"] = *schedule.KeepAtLeastOneBackup
+		}
+
+		if schedule.RetentionPeriodInDays != nil {
+			output["retention_period_in_days"] = int(*schedule.RetentionPeriodInDays)
+		}
+
+		if schedule.StartTime != nil {
+			output["start_time"] = schedule.StartTime.Format(time.RFC3339)
+		}
+
+		schedules = append(schedules, output)
+	}
+	result["schedule"] = schedules
+
+	return append(results, result)
+}
+
+func appServiceLogsSchema() *schema.Schema {
+	logLevels := []string{
+		string(web.Off),
+		string(web.Error),
+		string(web.Warning),
+		string(web.Information),
+		string(web.Verbose),
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"application_logs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file_system_level": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      string(web.Off),
+								ValidateFunc: validation.StringInSlice(logLevels, false),
+							},
+
+							"azure_blob_storage": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"level": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(logLevels, false),
+										},
+
+										"sas_url": {
+											Type:      schema.TypeString,
+											Required:  true,
+											Sensitive: true,
+										},
+
+										"retention_in_days": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"http_logs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file_system": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"retention_in_mb": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(25, 100),
+										},
+
+										"retention_in_days": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+									},
+								},
+							},
+
+							"azure_blob_storage": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"sas_url": {
+											Type:      schema.TypeString,
+											Required:  true,
+											Sensitive: true,
+										},
+
+										"retention_in_days": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"detailed_error_messages_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"failed_request_tracing_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandAppServiceLogs(d *schema.ResourceData) web.SiteLogsConfig {
+	// any logging which isn't specified is switched off
+	props := web.SiteLogsConfigProperties{
+		ApplicationLogs: &web.ApplicationLogsConfig{
+			FileSystem: &web.FileSystemApplicationLogsConfig{
+				Level: web.Off,
+			},
+			AzureBlobStorage: &web.AzureBlobStorageApplicationLogsConfig{
+				Level: web.Off,
+			},
+		},
+		HTTPLogs: &web.HTTPLogsConfig{
+			FileSystem: &web.FileSystemHTTPLogsConfig{
+				Enabled: utils.Bool(false),
+			},
+			AzureBlobStorage: &web.AzureBlobStorageHTTPLogsConfig{
+				Enabled: utils.Bool(false),
+			},
+		},
+		DetailedErrorMessages: &web.EnabledConfig{
+			Enabled: utils.Bool(false),
+		},
+		FailedRequestsTracing: &web.EnabledConfig{
+			Enabled: utils.Bool(false),
+		},
+	}
+
+	logs := d.Get("logs").([]interface{})
+	if len(logs) > 0 && logs[0] != nil {
+		config := logs[0].(map[string]interface{})
+
+		props.DetailedErrorMessages.Enabled = utils.Bool(config["detailed_error_messages_enabled"].(bool))
+		props.FailedRequestsTracing.Enabled = utils.Bool(config["failed_request_tracing_enabled"].(bool))
+
+		if v := config["application_logs"].([]interface{}); len(v) > 0 && v[0] != nil {
+			applicationLogs := v[0].(map[string]interface{})
+			props.ApplicationLogs.FileSystem.Level = web.LogLevel(applicationLogs["file_system_level"].(string))
+
+			if blob := applicationLogs["azure_blob_storage"].([]interface{}); len(blob) > 0 && blob[0] != nil {
+				storage := blob[0].(map[string]interface{})
+				props.ApplicationLogs.AzureBlobStorage = &web.AzureBlobStorageApplicationLogsConfig{
+					Level:           web.LogLevel(storage["level"].(string)),
+					SasURL:          utils.String(storage["sas_url"].(string)),
+					RetentionInDays: utils.Int32(int32(storage["retention_in_days"].(int))),
+				}
+			}
+		}
+
+		if v := config["http_logs"].([]interface{}); len(v) > 0 && v[0] != nil {
+			httpLogs := v[0].(map[string]interface{})
+
+			if fileSystem := httpLogs["file_system"].([]interface{}); len(fileSystem) > 0 && fileSystem[0] != nil {
+				storage := fileSystem[0].(map[string]interface{})
+				props.HTTPLogs.FileSystem = &web.FileSystemHTTPLogsConfig{
+					Enabled:         utils.Bool(true),
+					RetentionInMb:   utils.Int32(int32(storage["retention_in_mb"].(int))),
+					RetentionInDays: utils.Int32(int32(storage["retention_in_days"].(int))),
+				}
+			}
+
+			if blob := httpLogs["azure_blob_storage"].([]interface{}); len(blob) > 0 && blob[0] != nil {
+				storage := blob[0].(map[string]interface{})
+				props.HTTPLogs.AzureBlobStorage = &web.AzureBlobStorageHTTPLogsConfig{
+					Enabled:         utils.Bool(true),
+					SasURL:          utils.String(storage["sas_url"].(string)),
+					RetentionInDays: utils.Int32(int32(storage["retention_in_days"].(int))),
+				}
+			}
+		}
+	}
+
+	return web.SiteLogsConfig{
+		SiteLogsConfigProperties: &props,
+	}
+}
+
+func flattenAppServiceLogs(input *web.SiteLogsConfigProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	result := map[string]interface{}{
+		"detailed_error_messages_enabled": false,
+		"failed_request_tracing_enabled":  false,
+	}
+
+	if v := input.DetailedErrorMessages; v != nil && v.Enabled != nil {
+		result["detailed_error_messages_enabled"] = *v.Enabled
+	}
+
+	if v := input.FailedRequestsTracing; v != nil && v.Enabled != nil {
+		result["failed_request_tracing_enabled"] = *v.Enabled
+	}
+
+	applicationLogs := map[string]interface{}{
+		"file_system_level": string(web.Off),
+	}
+	applicationBlobStorage := make([]interface{}, 0)
+	if v := input.ApplicationLogs; v != nil {
+		if v.FileSystem != nil && v.FileSystem.Level != "" {
+			applicationLogs["file_system_level"] = string(v.FileSystem.Level)
+		}
+
+		if blob := v.AzureBlobStorage; blob != nil && blob.SasURL != nil && blob.Level != web.Off {
+			storage := map[string]interface{}{
+				"level":   string(blob.Level),
+				"sas_url": *blob.SasURL,
+			}
+			if blob.RetentionInDays != nil {
+				storage["retention_in_days"] = int(*blob.RetentionInDays)
+			}
+			applicationBlobStorage = append(applicationBlobStorage, storage)
+		}
+	}
+	applicationLogs["azure_blob_storage"] = applicationBlobStorage
+	result["application_logs"] = []interface{}{applicationLogs}
+
+	httpFileSystem := make([]interface{}, 0)
+	httpBlobStorage := make([]interface{}, 0)
+	if v := input.HTTPLogs; v != nil {
+		if fs := v.FileSystem; fs != nil && fs.Enabled != nil && *fs.Enabled {
+			storage := make(map[string]interface{}, 0)
+			if fs.RetentionInMb != nil {
+				storage["retention_in_mb"] = int(*fs.RetentionInMb)
+			}
+			if fs.RetentionInDays != nil {
+				storage["retention_in_days"] = int(*fs.RetentionInDays)
+			}
+			httpFileSystem = append(httpFileSystem, storage)
+		}
+
+		if blob := v.AzureBlobStorage; blob != nil && blob.Enabled != nil && *blob.Enabled {
+			storage := make(map[string]interface{}, 0)
+			if blob.SasURL != nil {
+				storage["sas_url"] = *blob.SasURL
+			}
+			if blob.RetentionInDays != nil {
+				storage["retention_in_days"] = int(*blob.RetentionInDays)
+			}
+			httpBlobStorage = append(httpBlobStorage, storage)
+		}
+	}
+	result["http_logs"] = []interface{}{
+		map[string]interface{}{
+			"file_system":        httpFileSystem,
+			"azure_blob_storage": httpBlobStorage,
+		},
+	}
+
+	return append(results, result)
+}
+
+func appServiceSourceControlSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"repo_url": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"branch": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "master",
+				},
+
+				"manual_integration": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"rollback_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"use_mercurial": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandAppServiceSourceControl(d *schema.ResourceData) *web.SiteSourceControl {
+	sourceControls := d.Get("source_control").([]interface{})
+	if len(sourceControls) == 0 || sourceControls[0] == nil {
+		return nil
+	}
+
+	config := sourceControls[0].(map[string]interface{})
+
+	return &web.SiteSourceControl{
+		SiteSourceControlProperties: &web.SiteSourceControlProperties{
+			RepoURL:                   utils.String(config["repo_url"].(string)),
+			Branch:                    utils.String(config["branch"].(string)),
+			IsManualIntegration:       utils.Bool(config["manual_integration"].(bool)),
+			DeploymentRollbackEnabled: utils.Bool(config["rollback_enabled"].(bool)),
+			IsMercurial:               utils.Bool(config["use_mercurial"].(bool)),
+		},
+	}
+}
+
+func flattenAppServiceSourceControl(input *web.SiteSourceControlProperties) []interface{} {
+	results := make([]interface{}, 0)
+
+	// when Source Control isn't configured the API returns an empty Repo URL
+	if input == nil || input.RepoURL == nil || *input.RepoURL == "" {
+		return results
+	}
+
+	result := map[string]interface{}{
+		"repo_url": *input.RepoURL,
+	}
+
+	if input.Branch != nil {
+		result["branch"] = *input.Branch
+	}
+
+	if input.IsManualIntegration != nil {
+		result["manual_integration"] = *input.IsManualIntegration
+	}
+
+	if input.DeploymentRollbackEnabled != nil {
+		result["rollback_enabled"] = *input.DeploymentRollbackEnabled
+	}
+
+	if input.IsMercurial != nil {
+		result["use_mercurial"] = *input.IsMercurial
+	}
+
+	return append(results, result)
+}
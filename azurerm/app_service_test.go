@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandAppServiceHTTPSOnly(t *testing.T) {
+	for _, httpsOnly := range []bool{true, false} {
+		d := resourceArmAppService().TestResourceData()
+		d.Set("location", "westeurope")
+		d.Set("app_service_plan_id", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/serverfarms/plan1")
+		d.Set("https_only", httpsOnly)
+
+		site := expandAppService(d)
+		if site.SiteProperties.HTTPSOnly == nil || *site.SiteProperties.HTTPSOnly != httpsOnly {
+			t.Fatalf("Expected `HTTPSOnly` to be %t but got %+v", httpsOnly, site.SiteProperties.HTTPSOnly)
+		}
+	}
+}
+
+func TestAppServiceAuthSettingsLoginParams(t *testing.T) {
+	input := map[string]interface{}{
+		"resource":    "https://graph.windows.net",
+		"domain_hint": "contoso.com",
+		"prompt":      "a=b",
+	}
+
+	expanded := expandAppServiceAuthSettingsLoginParams(input)
+	expected := []string{"domain_hint=contoso.com", "prompt=a=b", "resource=https://graph.windows.net"}
+	if !reflect.DeepEqual(*expanded, expected) {
+		t.Fatalf("Expected the Login Params to be %+v but got %+v", expected, *expanded)
+	}
+
+	flattened := flattenAppServiceAuthSettingsLoginParams(expanded)
+	if !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Expected the Login Params to be %+v but got %+v", input, flattened)
+	}
+}
+
+func TestFlattenAppServiceAuthSettings(t *testing.T) {
+	disabled := &web.SiteAuthSettingsProperties{
+		Enabled:                    utils.Bool(false),
+		TokenRefreshExtensionHours: utils.Float(72),
+	}
+	if output := flattenAppServiceAuthSettings(disabled); len(output) != 0 {
+		t.Fatalf("Expected no Auth Settings when disabled without any providers but got %+v", output)
+	}
+
+	enabled := &web.SiteAuthSettingsProperties{
+		Enabled:         utils.Bool(true),
+		DefaultProvider: web.Google,
+		GoogleClientID:  utils.String("google-client-id"),
+	}
+	output := flattenAppServiceAuthSettings(enabled)
+	if len(output) != 1 {
+		t.Fatalf("Expected 1 Auth Settings block but got %d", len(output))
+	}
+
+	result := output[0].(map[string]interface{})
+	if result["default_provider"] != "Google" {
+		t.Fatalf("Expected the Default Provider to be %q but got %q", "Google", result["default_provider"])
+	}
+
+	google := result["google"].([]interface{})
+	if len(google) != 1 {
+		t.Fatalf("Expected 1 Google block but got %d", len(google))
+	}
+
+	if clientId := google[0].(map[string]interface{})["client_id"]; clientId != "google-client-id" {
+		t.Fatalf("Expected the Google Client ID to be %q but got %q", "google-client-id", clientId)
+	}
+
+	if activeDirectory := result["active_directory"].([]interface{}); len(activeDirectory) != 0 {
+		t.Fatalf("Expected no Active Directory block but got %d", len(activeDirectory))
+	}
+}
+
+func TestAppServiceBackupRoundTrip(t *testing.T) {
+	d := resourceArmAppService().TestResourceData()
+	backup := []interface{}{
+		map[string]interface{}{
+			"name":                "daily",
+			"enabled":             true,
+			"storage_account_url": "https://example.blob.core.windows.net/backups?sv=2015-04-05",
+			"schedule": []interface{}{
+				map[string]interface{}{
+					"frequency_interval":       1,
+					"frequency_unit":           "Day",
+					"keep_at_least_one_backup": true,
+					"retention_period_in_days": 7,
+					"start_time":               "2017-11-01T03:00:00Z",
+				},
+			},
+		},
+	}
+	if err := d.Set("backup", backup); err != nil {
+		t.Fatalf("Error setting `backup`: %+v", err)
+	}
+
+	expanded, err := expandAppServiceBackup(d)
+	if err != nil {
+		t.Fatalf("Error expanding `backup`: %+v", err)
+	}
+
+	if *expanded.BackupSchedule.FrequencyInterval != 1 || expanded.BackupSchedule.FrequencyUnit != web.Day {
+		t.Fatalf("Expected a Frequency of 1 Day but got %d %s", *expanded.BackupSchedule.FrequencyInterval, expanded.BackupSchedule.FrequencyUnit)
+	}
+
+	flattened := flattenAppServiceBackup(expanded.BackupRequestProperties)
+	if !reflect.DeepEqual(flattened, backup) {
+		t.Fatalf("Expected the flattened Backup to be %+v but got %+v", backup, flattened)
+	}
+}
+
+func TestFlattenAppServiceLogs(t *testing.T) {
+	input := &web.SiteLogsConfigProperties{
+		ApplicationLogs: &web.ApplicationLogsConfig{
+			FileSystem: &web.FileSystemApplicationLogsConfig{
+				Level: web.Off,
+			},
+			AzureBlobStorage: &web.AzureBlobStorageApplicationLogsConfig{
+				Level: web.Off,
+			},
+		},
+		HTTPLogs: &web.HTTPLogsConfig{
+			FileSystem: &web.FileSystemHTTPLogsConfig{
+				Enabled:         utils.Bool(true),
+				RetentionInMb:   utils.Int32(35),
+				RetentionInDays: utils.Int32(7),
+			},
+			AzureBlobStorage: &web.AzureBlobStorageHTTPLogsConfig{
+				Enabled: utils.Bool(false),
+			},
+		},
+		DetailedErrorMessages: &web.EnabledConfig{
+			Enabled: utils.Bool(true),
+		},
+	}
+
+	output := flattenAppServiceLogs(input)
+	if len(output) != 1 {
+		t.Fatalf("Expected 1 Logs block but got %d", len(output))
+	}
+
+	result := output[0].(map[string]interface{})
+	if result["detailed_error_messages_enabled"] != true || result["failed_request_tracing_enabled"] != false {
+		t.Fatalf("Unexpected Enabled flags: %+v", result)
+	}
+
+	applicationLogs := result["application_logs"].([]interface{})[0].(map[string]interface{})
+	if blob := applicationLogs["azure_blob_storage"].([]interface{}); len(blob) != 0 {
+		t.Fatalf("Expected no Application Blob Storage logging when it's switched off but got %+v", blob)
+	}
+
+	httpLogs := result["http_logs"].([]interface{})[0].(map[string]interface{})
+	fileSystem := httpLogs["file_system"].([]interface{})
+	if len(fileSystem) != 1 {
+		t.Fatalf("Expected 1 HTTP File System block but got %d", len(fileSystem))
+	}
+	if v := fileSystem[0].(map[string]interface{})["retention_in_mb"]; v != 35 {
+		t.Fatalf("Expected the HTTP File System retention to be 35MB but got %+v", v)
+	}
+	if blob := httpLogs["azure_blob_storage"].([]interface{}); len(blob) != 0 {
+		t.Fatalf("Expected no HTTP Blob Storage logging when it's disabled but got %+v", blob)
+	}
+}
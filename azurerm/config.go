@@ -30,8 +30,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/arm/sql"
 	"github.com/Azure/azure-sdk-for-go/arm/storage"
 	"github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
-	"github.com/Azure/azure-sdk-for-go/arm/web"
 	keyVault "github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
@@ -58,8 +58,9 @@ type ArmClient struct {
 	vmClient               compute.VirtualMachinesClient
 	imageClient            compute.ImagesClient
 
-	diskClient     disk.DisksClient
-	cosmosDBClient cosmosdb.DatabaseAccountsClient
+	diskClient      disk.DisksClient
+	snapshotsClient disk.SnapshotsClient
+	cosmosDBClient  cosmosdb.DatabaseAccountsClient
 
 	appGatewayClient             network.ApplicationGatewaysClient
 	ifaceClient                  network.InterfacesClient
@@ -130,10 +131,12 @@ type ArmClient struct {
 	sqlFirewallRulesClient sql.FirewallRulesClient
 	sqlServersClient       sql.ServersClient
 
-	appServicePlansClient web.AppServicePlansClient
+	appServicePlansClient        web.AppServicePlansClient
+	appServiceCertificatesClient web.CertificatesClient
 
 	appInsightsClient appinsights.ComponentsClient
 
+	applicationsClient      graphrbac.ApplicationsClient
 	servicePrincipalsClient graphrbac.ServicePrincipalsClient
 
 	appsClient web.AppsClient
@@ -305,6 +308,12 @@ func (c *Config) getArmClient() (*ArmClient, error) {
 	dkc.Sender = autorest.CreateSender(withRequestLogging())
 	client.diskClient = dkc
 
+	snapshotsClient := disk.NewSnapshotsClientWithBaseURI(endpoint, c.SubscriptionID)
+	setUserAgent(&snapshotsClient.Client)
+	snapshotsClient.Authorizer = auth
+	snapshotsClient.Sender = autorest.CreateSender(withRequestLogging())
+	client.snapshotsClient = snapshotsClient
+
 	img := compute.NewImagesClientWithBaseURI(endpoint, c.SubscriptionID)
 	setUserAgent(&img.Client)
 	img.Authorizer = auth
@@ -605,12 +614,24 @@ func (c *Config) getArmClient() (*ArmClient, error) {
 	aspc.Sender = autorest.CreateSender(withRequestLogging())
 	client.appServicePlansClient = aspc
 
+	ascc := web.NewCertificatesClientWithBaseURI(endpoint, c.SubscriptionID)
+	setUserAgent(&ascc.Client)
+	ascc.Authorizer = auth
+	ascc.Sender = autorest.CreateSender(withRequestLogging())
+	client.appServiceCertificatesClient = ascc
+
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, c.SubscriptionID)
 	setUserAgent(&ai.Client)
 	ai.Authorizer = auth
 	ai.Sender = autorest.CreateSender(withRequestLogging())
 	client.appInsightsClient = ai
 
+	aadac := graphrbac.NewApplicationsClientWithBaseURI(graphEndpoint, c.TenantID)
+	setUserAgent(&aadac.Client)
+	aadac.Authorizer = graphAuth
+	aadac.Sender = autorest.CreateSender(withRequestLogging())
+	client.applicationsClient = aadac
+
 	spc := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, c.TenantID)
 	setUserAgent(&spc.Client)
 	spc.Authorizer = graphAuth
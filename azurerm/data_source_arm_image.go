@@ -0,0 +1,262 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmImageRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+			},
+
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateRegex,
+				ConflictsWith: []string{"name"},
+			},
+
+			// only used with `name_regex`, where the Images are sorted by name and the first is used
+			"sort_descending": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_disk": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blob_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"caching": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managed_disk_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"data_disk": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blob_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"caching": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lun": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"managed_disk_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).imageClient
+
+	resGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	if name == "" && nameRegex == "" {
+		return fmt.Errorf("Error: either `name` or `name_regex` must be specified")
+	}
+
+	var image compute.Image
+	if name != "" {
+		resp, err := client.Get(resGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error: Image %q (Resource Group %q) was not found", name, resGroup)
+			}
+			return fmt.Errorf("Error making Read request on Image %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+		image = resp
+	} else {
+		r, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return fmt.Errorf("Error compiling `name_regex` %q: %+v", nameRegex, err)
+		}
+
+		images := make([]compute.Image, 0)
+		resp, err := client.ListByResourceGroup(resGroup)
+		for {
+			if err != nil {
+				return fmt.Errorf("Error listing Images in Resource Group %q: %+v", resGroup, err)
+			}
+			if resp.Value != nil {
+				images = append(images, *resp.Value...)
+			}
+			if resp.NextLink == nil || *resp.NextLink == "" {
+				break
+			}
+			resp, err = client.ListByResourceGroupNextResults(resp)
+		}
+
+		found := findArmImageByNameRegex(images, r, d.Get("sort_descending").(bool))
+		if found == nil {
+			return fmt.Errorf("Error: no Images matching the regex %q were found in Resource Group %q", nameRegex, resGroup)
+		}
+		log.Printf("[DEBUG] Image %q matched the regex %q", *found.Name, nameRegex)
+		image = *found
+	}
+
+	d.SetId(*image.ID)
+	d.Set("name", image.Name)
+	d.Set("resource_group_name", resGroup)
+	if location := image.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := image.ImageProperties; props != nil && props.StorageProfile != nil {
+		if err := d.Set("os_disk", flattenAzureRmImageDataSourceOsDisk(props.StorageProfile.OsDisk)); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		if err := d.Set("data_disk", flattenAzureRmImageDataSourceDataDisks(props.StorageProfile.DataDisks)); err != nil {
+			return fmt.Errorf("Error setting `data_disk`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, image.Tags)
+
+	return nil
+}
+
+// findArmImageByNameRegex returns the first Image whose name matches the regex once sorted by name, or nil
+func findArmImageByNameRegex(images []compute.Image, r *regexp.Regexp, sortDescending bool) *compute.Image {
+	matches := make([]compute.Image, 0)
+	for _, image := range images {
+		if image.Name != nil && r.MatchString(*image.Name) {
+			matches = append(matches, image)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if sortDescending {
+			return *matches[i].Name > *matches[j].Name
+		}
+		return *matches[i].Name < *matches[j].Name
+	})
+
+	return &matches[0]
+}
+
+func flattenAzureRmImageDataSourceOsDisk(input *compute.ImageOSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"caching":  string(input.Caching),
+		"os_state": string(input.OsState),
+		"os_type":  string(input.OsType),
+	}
+
+	if input.BlobURI != nil {
+		output["blob_uri"] = *input.BlobURI
+	}
+	if input.ManagedDisk != nil && input.ManagedDisk.ID != nil {
+		output["managed_disk_id"] = *input.ManagedDisk.ID
+	}
+	if input.DiskSizeGB != nil {
+		output["size_gb"] = int(*input.DiskSizeGB)
+	}
+
+	return []interface{}{output}
+}
+
+func flattenAzureRmImageDataSourceDataDisks(input *[]compute.ImageDataDisk) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, disk := range *input {
+		result := map[string]interface{}{
+			"caching": string(disk.Caching),
+		}
+
+		if disk.BlobURI != nil {
+			result["blob_uri"] = *disk.BlobURI
+		}
+		if disk.Lun != nil {
+			result["lun"] = int(*disk.Lun)
+		}
+		if disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+			result["managed_disk_id"] = *disk.ManagedDisk.ID
+		}
+		if disk.DiskSizeGB != nil {
+			result["size_gb"] = int(*disk.DiskSizeGB)
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
@@ -0,0 +1,202 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMImage_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_image.test"
+	ri := acctest.RandInt()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	userName := "testadmin"
+	password := "Password1234!"
+	hostName := fmt.Sprintf("tftestcustomimagesrc%d", ri)
+	sshPort := "22"
+	location := testLocation()
+	preConfig := testAccAzureRMImage_standaloneImage_setup(ri, userName, password, hostName, location)
+	postConfig := testAccDataSourceAzureRMImage_basic(ri, userName, password, hostName, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				//need to create a vm and then reference it in the image creation
+				Config:  preConfig,
+				Destroy: false,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureVMExists("azurerm_virtual_machine.testsource", true),
+					testGeneralizeVMImage(resourceGroup, "testsource", userName, password, hostName, sshPort, location),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "accteste"),
+					resource.TestCheckResourceAttr(dataSourceName, "os_disk.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "os_disk.0.os_type", "Linux"),
+					resource.TestCheckResourceAttr(dataSourceName, "os_disk.0.size_gb", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "data_disk.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMImage_nameRegex(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	userName := "testadmin"
+	password := "Password1234!"
+	hostName := fmt.Sprintf("tftestcustomimagesrc%d", ri)
+	sshPort := "22"
+	location := testLocation()
+	preConfig := testAccAzureRMImage_standaloneImage_setup(ri, userName, password, hostName, location)
+	postConfig := testAccDataSourceAzureRMImage_nameRegex(ri, userName, password, hostName, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				//need to create a vm and then reference it in the image creation
+				Config:  preConfig,
+				Destroy: false,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureVMExists("azurerm_virtual_machine.testsource", true),
+					testGeneralizeVMImage(resourceGroup, "testsource", userName, password, hostName, sshPort, location),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_image.ascending", "name", "app-20180101"),
+					resource.TestCheckResourceAttr("data.azurerm_image.descending", "name", "app-20180102"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindArmImageByNameRegex(t *testing.T) {
+	images := []compute.Image{
+		{Name: utils.String("app-20180102")},
+		{Name: utils.String("other-20180103")},
+		{Name: utils.String("app-20171231")},
+		{Name: utils.String("app-20180101")},
+	}
+
+	cases := []struct {
+		Regex          string
+		SortDescending bool
+		Expected       string
+	}{
+		{
+			Regex:          "^app-",
+			SortDescending: false,
+			Expected:       "app-20171231",
+		},
+		{
+			Regex:          "^app-",
+			SortDescending: true,
+			Expected:       "app-20180102",
+		},
+		{
+			Regex:          "-2018",
+			SortDescending: true,
+			Expected:       "other-20180103",
+		},
+		{
+			Regex:          "^missing-",
+			SortDescending: true,
+			Expected:       "",
+		},
+	}
+
+	for _, tc := range cases {
+		image := findArmImageByNameRegex(images, regexp.MustCompile(tc.Regex), tc.SortDescending)
+		if tc.Expected == "" {
+			if image != nil {
+				t.Fatalf("Expected no image to match %q but got %q", tc.Regex, *image.Name)
+			}
+			continue
+		}
+
+		if image == nil {
+			t.Fatalf("Expected the image %q to match %q but got nil", tc.Expected, tc.Regex)
+		}
+		if *image.Name != tc.Expected {
+			t.Fatalf("Expected the image %q to match %q (sort descending %t) but got %q", tc.Expected, tc.Regex, tc.SortDescending, *image.Name)
+		}
+	}
+}
+
+func testAccDataSourceAzureRMImage_basic(rInt int, userName string, password string, hostName string, location string) string {
+	template := testAccAzureRMImage_standaloneImage_provision(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_image" "test" {
+  name                = "${azurerm_image.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMImage_nameRegex(rInt int, userName string, password string, hostName string, location string) string {
+	template := testAccAzureRMImage_standaloneImage_setup(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image" "first" {
+  name                = "app-20180101"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  os_disk {
+    os_type  = "Linux"
+    os_state = "Generalized"
+    blob_uri = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/myosdisk1.vhd"
+    size_gb  = 30
+    caching  = "None"
+  }
+}
+
+resource "azurerm_image" "second" {
+  name                = "app-20180102"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  os_disk {
+    os_type  = "Linux"
+    os_state = "Generalized"
+    blob_uri = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/myosdisk1.vhd"
+    size_gb  = 30
+    caching  = "None"
+  }
+}
+
+data "azurerm_image" "ascending" {
+  name_regex          = "^app-"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  depends_on          = ["azurerm_image.first", "azurerm_image.second"]
+}
+
+data "azurerm_image" "descending" {
+  name_regex          = "^app-"
+  sort_descending     = true
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  depends_on          = ["azurerm_image.first", "azurerm_image.second"]
+}
+`, template)
+}
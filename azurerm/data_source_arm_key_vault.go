@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmKeyVault() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKeyVaultName,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"access_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"application_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"key_permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"secret_permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"enabled_for_deployment": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enabled_for_disk_encryption": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enabled_for_template_deployment": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: KeyVault %s (resource group %s) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on KeyVault %s (resource group %s): %+v", name, resGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		if tenantId := props.TenantID; tenantId != nil {
+			d.Set("tenant_id", tenantId.String())
+		}
+		d.Set("enabled_for_deployment", props.EnabledForDeployment)
+		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
+		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
+		d.Set("vault_uri", props.VaultURI)
+
+		if sku := props.Sku; sku != nil {
+			if err := d.Set("sku", flattenKeyVaultSku(sku)); err != nil {
+				return fmt.Errorf("Error flattening `sku`: %+v", err)
+			}
+		}
+
+		if err := d.Set("access_policy", flattenKeyVaultAccessPolicies(props.AccessPolicies)); err != nil {
+			return fmt.Errorf("Error flattening `access_policy`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+// keyVaultBaseUrlForDataSource returns the Base URL of the Key Vault referenced by a data source - which is either
+// specified directly via `vault_uri`, or looked up from the `vault_name` and `resource_group_name` fields
+func keyVaultBaseUrlForDataSource(d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("vault_uri"); ok {
+		return v.(string), nil
+	}
+
+	vaultName := d.Get("vault_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	if vaultName == "" || resGroup == "" {
+		return "", fmt.Errorf("Either `vault_uri` or both `vault_name` and `resource_group_name` must be specified")
+	}
+
+	client := meta.(*ArmClient).keyVaultClient
+	resp, err := client.Get(resGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return "", fmt.Errorf("Error: KeyVault %s (resource group %s) was not found", vaultName, resGroup)
+		}
+		return "", fmt.Errorf("Error making Read request on KeyVault %s (resource group %s): %+v", vaultName, resGroup, err)
+	}
+
+	if resp.Properties == nil || resp.Properties.VaultURI == nil {
+		return "", fmt.Errorf("Error: the URI of KeyVault %s (resource group %s) was nil", vaultName, resGroup)
+	}
+
+	return *resp.Properties.VaultURI, nil
+}
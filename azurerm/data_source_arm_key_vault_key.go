@@ -0,0 +1,141 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmKeyVaultKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKeyVaultSecretName,
+			},
+
+			"vault_uri": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vault_name", "resource_group_name"},
+			},
+
+			"vault_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateKeyVaultName,
+				ConflictsWith: []string{"vault_uri"},
+			},
+
+			"resource_group_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vault_uri"},
+			},
+
+			// an empty version indicates the latest version
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"key_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"key_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"key_opts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"n": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"e": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	keyVaultBaseUrl, err := keyVaultBaseUrlForDataSource(d, meta)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	resp, err := client.GetKey(keyVaultBaseUrl, name, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: KeyVault Key %q (version %q) was not found in KeyVault %q", name, version, keyVaultBaseUrl)
+		}
+		return fmt.Errorf("Error making Read request on Azure KeyVault Key %s: %+v", name, err)
+	}
+
+	key := resp.Key
+	if key == nil || key.Kid == nil {
+		return fmt.Errorf("Cannot read KeyVault Key '%s' (in key vault '%s')", name, keyVaultBaseUrl)
+	}
+
+	// the version may have changed, so parse the updated id
+	respID, err := parseKeyVaultSecretID(*key.Kid)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*key.Kid)
+
+	d.Set("name", respID.Name)
+	d.Set("vault_uri", respID.KeyVaultBaseUrl)
+	d.Set("version", respID.Version)
+	d.Set("key_type", string(key.Kty))
+	d.Set("n", key.N)
+	d.Set("e", key.E)
+
+	// the key size isn't returned from the API, but can be determined from the modulus of RSA keys
+	if key.N != nil {
+		size, err := keyVaultKeySizeFromModulus(*key.N)
+		if err != nil {
+			return fmt.Errorf("Error determining the size of KeyVault Key %s: %+v", name, err)
+		}
+		d.Set("key_size", size)
+	}
+
+	keyOpts := make([]string, 0)
+	if key.KeyOps != nil {
+		keyOpts = *key.KeyOps
+	}
+	if err := d.Set("key_opts", keyOpts); err != nil {
+		return fmt.Errorf("Error flattening `key_opts`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMKeyVaultKey_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_key.test"
+	rString := acctest.RandString(8)
+	config := testAccDataSourceAzureRMKeyVaultKey_basic(rString, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "key_type", "RSA"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_size", "2048"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_opts.#", "6"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "n"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "e"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", "azurerm_key_vault_key.test", "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMKeyVaultKey_vaultName(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_key.test"
+	rString := acctest.RandString(8)
+	config := testAccDataSourceAzureRMKeyVaultKey_vaultName(rString, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "key_type", "RSA"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vault_uri", "azurerm_key_vault.test", "vault_uri"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "n", "azurerm_key_vault_key.test", "n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMKeyVaultKey_basic(rString string, location string) string {
+	resource := testAccAzureRMKeyVaultKey_basicRSA(rString, location, "RSA")
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_key" "test" {
+  name      = "${azurerm_key_vault_key.test.name}"
+  vault_uri = "${azurerm_key_vault_key.test.vault_uri}"
+}
+`, resource)
+}
+
+func testAccDataSourceAzureRMKeyVaultKey_vaultName(rString string, location string) string {
+	resource := testAccAzureRMKeyVaultKey_basicRSA(rString, location, "RSA")
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_key" "test" {
+  name                = "${azurerm_key_vault_key.test.name}"
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+  version             = "${azurerm_key_vault_key.test.version}"
+}
+`, resource)
+}
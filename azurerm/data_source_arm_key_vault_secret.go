@@ -0,0 +1,107 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmKeyVaultSecret() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultSecretRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKeyVaultSecretName,
+			},
+
+			"vault_uri": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vault_name", "resource_group_name"},
+			},
+
+			"vault_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateKeyVaultName,
+				ConflictsWith: []string{"vault_uri"},
+			},
+
+			"resource_group_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vault_uri"},
+			},
+
+			// an empty version indicates the latest version
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	keyVaultBaseUrl, err := keyVaultBaseUrlForDataSource(d, meta)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	resp, err := client.GetSecret(keyVaultBaseUrl, name, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: KeyVault Secret %q (version %q) was not found in KeyVault %q", name, version, keyVaultBaseUrl)
+		}
+		return fmt.Errorf("Error making Read request on Azure KeyVault Secret %s: %+v", name, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Secret '%s' (in key vault '%s')", name, keyVaultBaseUrl)
+	}
+
+	// the version may have changed, so parse the updated id
+	respID, err := parseKeyVaultSecretID(*resp.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", respID.Name)
+	d.Set("vault_uri", respID.KeyVaultBaseUrl)
+	d.Set("version", respID.Version)
+	d.Set("value", resp.Value)
+	d.Set("content_type", resp.ContentType)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMKeyVaultSecret_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_secret.test"
+	rString := acctest.RandString(8)
+	config := testAccDataSourceAzureRMKeyVaultSecret_basic(rString, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", "<rick><morty /></rick>"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_type", "application/xml"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.hello", "world"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", "azurerm_key_vault_secret.test", "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMKeyVaultSecret_vaultNameAndVersion(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_secret.test"
+	rString := acctest.RandString(8)
+	config := testAccDataSourceAzureRMKeyVaultSecret_vaultNameAndVersion(rString, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", "<rick><morty /></rick>"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", "azurerm_key_vault_secret.test", "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vault_uri", "azurerm_key_vault.test", "vault_uri"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMKeyVaultSecret_basic(rString string, location string) string {
+	resource := testAccAzureRMKeyVaultSecret_complete(rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_secret" "test" {
+  name      = "${azurerm_key_vault_secret.test.name}"
+  vault_uri = "${azurerm_key_vault_secret.test.vault_uri}"
+}
+`, resource)
+}
+
+func testAccDataSourceAzureRMKeyVaultSecret_vaultNameAndVersion(rString string, location string) string {
+	resource := testAccAzureRMKeyVaultSecret_complete(rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_secret" "test" {
+  name                = "${azurerm_key_vault_secret.test.name}"
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+  version             = "${azurerm_key_vault_secret.test.version}"
+}
+`, resource)
+}
@@ -0,0 +1,86 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/arm/disk"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmManagedDiskSharedAccessSignature() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmManagedDiskSharedAccessSignatureRead,
+
+		Schema: map[string]*schema.Schema{
+			// either a Managed Disk or a Snapshot ID, both of which can be exported
+			"managed_disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"duration_in_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+
+			"access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(disk.Read),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(disk.Read),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"sas_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmManagedDiskSharedAccessSignatureRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	diskId := d.Get("managed_disk_id").(string)
+	id, err := parseAzureResourceID(diskId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+
+	grantAccessData := disk.GrantAccessData{
+		Access:            disk.AccessLevel(d.Get("access").(string)),
+		DurationInSeconds: utils.Int32(int32(d.Get("duration_in_seconds").(int))),
+	}
+
+	var accessResp <-chan disk.AccessURI
+	var accessErr <-chan error
+	if name, ok := id.Path["disks"]; ok {
+		accessResp, accessErr = armClient.diskClient.GrantAccess(resGroup, name, grantAccessData, make(chan struct{}))
+	} else if name, ok := id.Path["snapshots"]; ok {
+		accessResp, accessErr = armClient.snapshotsClient.GrantAccess(resGroup, name, grantAccessData, make(chan struct{}))
+	} else {
+		return fmt.Errorf("Error: `managed_disk_id` %q must be the ID of a Managed Disk or a Snapshot", diskId)
+	}
+
+	resp := <-accessResp
+	if err := <-accessErr; err != nil {
+		return fmt.Errorf("Error granting access to %q: %+v", diskId, err)
+	}
+
+	if resp.AccessURIOutput == nil || resp.AccessURIOutput.AccessURIRaw == nil || resp.AccessURIOutput.AccessURIRaw.AccessSAS == nil {
+		return fmt.Errorf("Error: no Shared Access Signature was returned for %q", diskId)
+	}
+
+	d.SetId(diskId)
+	d.Set("sas_url", *resp.AccessURIOutput.AccessURIRaw.AccessSAS)
+
+	return nil
+}
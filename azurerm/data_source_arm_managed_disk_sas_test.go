@@ -0,0 +1,73 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMManagedDiskSAS_disk(t *testing.T) {
+	dataSourceName := "data.azurerm_managed_disk_sas.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMManagedDiskSAS_disk(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMManagedDiskSAS_snapshot(t *testing.T) {
+	dataSourceName := "data.azurerm_managed_disk_sas.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMManagedDiskSAS_snapshot(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMManagedDiskSAS_disk(rInt int, location string) string {
+	template := testAccAzureRMSnapshot_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_managed_disk_sas" "test" {
+  managed_disk_id     = "${azurerm_managed_disk.test.id}"
+  duration_in_seconds = 3600
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMManagedDiskSAS_snapshot(rInt int, location string) string {
+	template := testAccAzureRMSnapshot_fromManagedDisk(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_managed_disk_sas" "test" {
+  managed_disk_id     = "${azurerm_snapshot.test.id}"
+  duration_in_seconds = 3600
+}
+`, template)
+}
@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmPlatformImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPlatformImageRead,
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"publisher": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"offer": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// when omitted the latest version is used
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmImageClient
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	publisher := d.Get("publisher").(string)
+	offer := d.Get("offer").(string)
+	sku := d.Get("sku").(string)
+	version := d.Get("version").(string)
+
+	result, err := client.List(location, publisher, offer, sku, "", nil, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Platform Images (Publisher %q / Offer %q / SKU %q) in %q: %+v", publisher, offer, sku, location, err)
+	}
+
+	images := make([]compute.VirtualMachineImageResource, 0)
+	if result.Value != nil {
+		images = *result.Value
+	}
+
+	image := findArmPlatformImageVersion(images, version)
+	if image == nil {
+		if version == "" {
+			return fmt.Errorf("Error: no versions of the Platform Image (Publisher %q / Offer %q / SKU %q) were found in %q", publisher, offer, sku, location)
+		}
+		return fmt.Errorf("Error: version %q of the Platform Image (Publisher %q / Offer %q / SKU %q) was not found in %q", version, publisher, offer, sku, location)
+	}
+
+	d.SetId(*image.ID)
+	d.Set("location", location)
+	d.Set("version", image.Name)
+
+	return nil
+}
+
+// findArmPlatformImageVersion returns the specified version of the Platform Image, or the latest version
+// when no version is specified - where the name of each image is its version (e.g. `16.04.201801050`)
+func findArmPlatformImageVersion(images []compute.VirtualMachineImageResource, version string) *compute.VirtualMachineImageResource {
+	candidates := make([]compute.VirtualMachineImageResource, 0)
+	for _, image := range images {
+		if image.ID == nil || image.Name == nil {
+			continue
+		}
+
+		if version != "" {
+			if strings.EqualFold(*image.Name, version) {
+				return &image
+			}
+			continue
+		}
+
+		candidates = append(candidates, image)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return comparePlatformImageVersions(*candidates[i].Name, *candidates[j].Name) > 0
+	})
+
+	return &candidates[0]
+}
+
+// comparePlatformImageVersions compares each dot-separated segment numerically where possible, returning a
+// positive number if a is newer than b, a negative number if it's older and zero if they're the same
+func comparePlatformImageVersions(a string, b string) int {
+	aSegments := strings.Split(a, ".")
+	bSegments := strings.Split(b, ".")
+
+	for i := 0; i < len(aSegments) && i < len(bSegments); i++ {
+		aInt, aErr := strconv.ParseInt(aSegments[i], 10, 64)
+		bInt, bErr := strconv.ParseInt(bSegments[i], 10, 64)
+		if aErr == nil && bErr == nil {
+			if aInt != bInt {
+				if aInt > bInt {
+					return 1
+				}
+				return -1
+			}
+			continue
+		}
+
+		if c := strings.Compare(aSegments[i], bSegments[i]); c != 0 {
+			return c
+		}
+	}
+
+	return len(aSegments) - len(bSegments)
+}
@@ -0,0 +1,138 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMPlatformImage_latest(t *testing.T) {
+	dataSourceName := "data.azurerm_platform_image.test"
+	config := testAccDataSourceAzureRMPlatformImage_basic(testLocation(), "")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttr(dataSourceName, "publisher", "Canonical"),
+					resource.TestCheckResourceAttr(dataSourceName, "offer", "UbuntuServer"),
+					resource.TestCheckResourceAttr(dataSourceName, "sku", "16.04-LTS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMPlatformImage_version(t *testing.T) {
+	dataSourceName := "data.azurerm_platform_image.test"
+	config := testAccDataSourceAzureRMPlatformImage_basic(testLocation(), "16.04.201801050")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "16.04.201801050"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindArmPlatformImageVersion(t *testing.T) {
+	images := []compute.VirtualMachineImageResource{
+		{ID: utils.String("/versions/16.04.201709190"), Name: utils.String("16.04.201709190")},
+		{ID: utils.String("/versions/16.04.201801050"), Name: utils.String("16.04.201801050")},
+		{ID: utils.String("/versions/16.04.201711211"), Name: utils.String("16.04.201711211")},
+		{ID: utils.String("/versions/16.04.20180109"), Name: utils.String("16.04.20180109")},
+	}
+
+	cases := []struct {
+		Version  string
+		Expected string
+	}{
+		{
+			Version:  "",
+			Expected: "16.04.201801050",
+		},
+		{
+			Version:  "16.04.201711211",
+			Expected: "16.04.201711211",
+		},
+		{
+			Version:  "16.04.201701010",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		image := findArmPlatformImageVersion(images, tc.Version)
+		if tc.Expected == "" {
+			if image != nil {
+				t.Fatalf("Expected no image for version %q but got %q", tc.Version, *image.Name)
+			}
+			continue
+		}
+
+		if image == nil {
+			t.Fatalf("Expected the image %q for version %q but got nil", tc.Expected, tc.Version)
+		}
+		if *image.Name != tc.Expected {
+			t.Fatalf("Expected the image %q for version %q but got %q", tc.Expected, tc.Version, *image.Name)
+		}
+	}
+
+	if image := findArmPlatformImageVersion([]compute.VirtualMachineImageResource{}, ""); image != nil {
+		t.Fatalf("Expected no image when there are no versions but got %q", *image.Name)
+	}
+}
+
+func TestComparePlatformImageVersions(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Expected int
+	}{
+		{A: "1.0.0", B: "1.0.0", Expected: 0},
+		{A: "1.0.10", B: "1.0.9", Expected: 1},
+		{A: "1.0.9", B: "1.0.10", Expected: -1},
+		{A: "2.0", B: "10.0", Expected: -1},
+		{A: "1.0.0.1", B: "1.0.0", Expected: 1},
+		{A: "1.0.b", B: "1.0.a", Expected: 1},
+	}
+
+	for _, tc := range cases {
+		actual := comparePlatformImageVersions(tc.A, tc.B)
+		if (actual > 0) != (tc.Expected > 0) || (actual < 0) != (tc.Expected < 0) {
+			t.Fatalf("Expected comparing %q and %q to return %d but got %d", tc.A, tc.B, tc.Expected, actual)
+		}
+	}
+}
+
+func testAccDataSourceAzureRMPlatformImage_basic(location string, version string) string {
+	versionArg := ""
+	if version != "" {
+		versionArg = fmt.Sprintf("version   = %q", version)
+	}
+
+	return fmt.Sprintf(`
+data "azurerm_platform_image" "test" {
+  location  = "%s"
+  publisher = "Canonical"
+  offer     = "UbuntuServer"
+  sku       = "16.04-LTS"
+  %s
+}
+`, location, versionArg)
+}
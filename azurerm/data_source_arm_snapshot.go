@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSnapshotRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_option": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disk_size_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"encryption_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"disk_encryption_key": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"secret_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"source_vault_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"key_encryption_key": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"source_vault_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).snapshotsClient
+
+	resGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Snapshot %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Snapshot %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("os_type", string(props.OsType))
+
+		if props.DiskSizeGB != nil {
+			d.Set("disk_size_gb", int(*props.DiskSizeGB))
+		}
+
+		if props.TimeCreated != nil {
+			d.Set("time_created", props.TimeCreated.String())
+		}
+
+		if data := props.CreationData; data != nil {
+			d.Set("create_option", string(data.CreateOption))
+
+			if data.SourceURI != nil {
+				d.Set("source_uri", *data.SourceURI)
+			}
+
+			if data.SourceResourceID != nil {
+				d.Set("source_resource_id", *data.SourceResourceID)
+			}
+
+			if data.StorageAccountID != nil {
+				d.Set("storage_account_id", *data.StorageAccountID)
+			}
+		}
+
+		if err := d.Set("encryption_settings", flattenSnapshotEncryptionSettings(props.EncryptionSettings)); err != nil {
+			return fmt.Errorf("Error flattening `encryption_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
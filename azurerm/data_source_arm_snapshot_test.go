@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSnapshot_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_snapshot.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMSnapshot_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "time_created"),
+					resource.TestCheckResourceAttr(dataSourceName, "create_option", "Copy"),
+					resource.TestCheckResourceAttr(dataSourceName, "disk_size_gb", "10"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_resource_id", "azurerm_managed_disk.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSnapshot_basic(rInt int, location string) string {
+	resource := testAccAzureRMSnapshot_fromManagedDisk(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_snapshot" "test" {
+  name                = "${azurerm_snapshot.test.name}"
+  resource_group_name = "${azurerm_snapshot.test.resource_group_name}"
+}
+`, resource)
+}
@@ -0,0 +1,217 @@
+package azurerm
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var storageAccountSASServiceFlags = []storageSASFlag{
+	{Key: "blob", Value: "b"},
+	{Key: "queue", Value: "q"},
+	{Key: "table", Value: "t"},
+	{Key: "file", Value: "f"},
+}
+
+var storageAccountSASResourceTypeFlags = []storageSASFlag{
+	{Key: "service", Value: "s"},
+	{Key: "container", Value: "c"},
+	{Key: "object", Value: "o"},
+}
+
+var storageAccountSASPermissionFlags = []storageSASFlag{
+	{Key: "read", Value: "r"},
+	{Key: "write", Value: "w"},
+	{Key: "delete", Value: "d"},
+	{Key: "list", Value: "l"},
+	{Key: "add", Value: "a"},
+	{Key: "create", Value: "c"},
+	{Key: "update", Value: "u"},
+	{Key: "process", Value: "p"},
+}
+
+func dataSourceArmStorageAccountSharedAccessSignature() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageAccountSharedAccessSignatureRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"ip_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStorageSASIPRange,
+			},
+
+			"services": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: storageSASFlagsSchema(storageAccountSASServiceFlags),
+				},
+			},
+
+			"resource_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: storageSASFlagsSchema(storageAccountSASResourceTypeFlags),
+				},
+			},
+
+			"permissions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: storageSASFlagsSchema(storageAccountSASPermissionFlags),
+				},
+			},
+
+			"start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Date,
+			},
+
+			"expiry": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339Date,
+			},
+
+			"sas": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"blob_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"queue_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"table_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"file_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageAccountSharedAccessSignatureRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	accountName := d.Get("storage_account_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	key, accountExists, err := armClient.getKeyForStorageAccount(resGroup, accountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Error: Storage Account %q (resource group %q) was not found", accountName, resGroup)
+	}
+
+	services := buildStorageSASFlags(d.Get("services").([]interface{}), storageAccountSASServiceFlags)
+	if services == "" {
+		return fmt.Errorf("At least one of the `services` must be enabled")
+	}
+
+	resourceTypes := buildStorageSASFlags(d.Get("resource_types").([]interface{}), storageAccountSASResourceTypeFlags)
+	if resourceTypes == "" {
+		return fmt.Errorf("At least one of the `resource_types` must be enabled")
+	}
+
+	permissions := buildStorageSASFlags(d.Get("permissions").([]interface{}), storageAccountSASPermissionFlags)
+	if permissions == "" {
+		return fmt.Errorf("At least one of the `permissions` must be enabled")
+	}
+
+	params := storageAccountSASParameters{
+		AccountName:   accountName,
+		AccountKey:    key,
+		Services:      services,
+		ResourceTypes: resourceTypes,
+		Permissions:   permissions,
+		Start:         d.Get("start").(string),
+		Expiry:        d.Get("expiry").(string),
+		IPRange:       d.Get("ip_range").(string),
+		HTTPSOnly:     d.Get("https_only").(bool),
+	}
+
+	token, err := computeStorageAccountSASToken(params)
+	if err != nil {
+		return fmt.Errorf("Error computing the Shared Access Signature for Storage Account %q: %+v", accountName, err)
+	}
+
+	sas := fmt.Sprintf("?%s", token)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(sas))))
+	d.Set("sas", sas)
+
+	suffix := armClient.environment.StorageEndpointSuffix
+	for _, service := range storageAccountSASServiceFlags {
+		url := ""
+		if containsStorageSASFlag(services, service.Value) {
+			url = fmt.Sprintf("https://%s.%s.%s/%s", accountName, service.Key, suffix, sas)
+		}
+		d.Set(fmt.Sprintf("%s_url", service.Key), url)
+	}
+
+	return nil
+}
+
+func storageSASFlagsSchema(flags []storageSASFlag) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(flags))
+
+	for _, flag := range flags {
+		result[flag.Key] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+	}
+
+	return result
+}
+
+func containsStorageSASFlag(flags string, flag string) bool {
+	for _, f := range flags {
+		if string(f) == flag {
+			return true
+		}
+	}
+
+	return false
+}
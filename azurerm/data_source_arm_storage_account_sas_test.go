@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMStorageAccountSas_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_account_sas.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccDataSourceAzureRMStorageAccountSas_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("ss=b&")),
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("srt=sco&")),
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("sp=rl&")),
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("spr=https&")),
+					resource.TestMatchResourceAttr(dataSourceName, "blob_url", regexpMustContain(fmt.Sprintf("https://acctestsads%s.blob.", rs))),
+					resource.TestCheckResourceAttr(dataSourceName, "queue_url", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "table_url", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "file_url", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageAccountSas_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestsads%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+}
+
+data "azurerm_storage_account_sas" "test" {
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  resource_group_name  = "${azurerm_storage_account.test.resource_group_name}"
+  https_only           = true
+  start                = "2017-03-21T00:00:00Z"
+  expiry               = "2099-03-21T00:00:00Z"
+
+  resource_types {
+    service   = true
+    container = true
+    object    = true
+  }
+
+  services {
+    blob = true
+  }
+
+  permissions {
+    read = true
+    list = true
+  }
+}
+`, rInt, location, rString)
+}
@@ -0,0 +1,145 @@
+package azurerm
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var storageBlobSASPermissionFlags = []storageSASFlag{
+	{Key: "read", Value: "r"},
+	{Key: "add", Value: "a"},
+	{Key: "create", Value: "c"},
+	{Key: "write", Value: "w"},
+	{Key: "delete", Value: "d"},
+	{Key: "list", Value: "l"},
+}
+
+func dataSourceArmStorageBlobSharedAccessSignature() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageBlobSharedAccessSignatureRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// when omitted the Shared Access Signature is scoped to the Container
+			"blob_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"ip_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStorageSASIPRange,
+			},
+
+			"permissions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: storageSASFlagsSchema(storageBlobSASPermissionFlags),
+				},
+			},
+
+			"start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Date,
+			},
+
+			"expiry": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339Date,
+			},
+
+			"sas": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageBlobSharedAccessSignatureRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	accountName := d.Get("storage_account_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	containerName := d.Get("container_name").(string)
+	blobName := d.Get("blob_name").(string)
+
+	key, accountExists, err := armClient.getKeyForStorageAccount(resGroup, accountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Error: Storage Account %q (resource group %q) was not found", accountName, resGroup)
+	}
+
+	permissions := buildStorageSASFlags(d.Get("permissions").([]interface{}), storageBlobSASPermissionFlags)
+	if permissions == "" {
+		return fmt.Errorf("At least one of the `permissions` must be enabled")
+	}
+	if blobName != "" && containsStorageSASFlag(permissions, "l") {
+		return fmt.Errorf("The `list` permission can only be granted when the Shared Access Signature is scoped to a Container")
+	}
+
+	params := storageBlobSASParameters{
+		AccountName:   accountName,
+		AccountKey:    key,
+		ContainerName: containerName,
+		BlobName:      blobName,
+		Permissions:   permissions,
+		Start:         d.Get("start").(string),
+		Expiry:        d.Get("expiry").(string),
+		IPRange:       d.Get("ip_range").(string),
+		HTTPSOnly:     d.Get("https_only").(bool),
+	}
+
+	token, err := computeStorageBlobSASToken(params)
+	if err != nil {
+		return fmt.Errorf("Error computing the Shared Access Signature for Container %q (Storage Account %q): %+v", containerName, accountName, err)
+	}
+
+	sas := fmt.Sprintf("?%s", token)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(sas))))
+	d.Set("sas", sas)
+
+	url := fmt.Sprintf("https://%s.blob.%s/%s", accountName, armClient.environment.StorageEndpointSuffix, containerName)
+	if blobName != "" {
+		url = fmt.Sprintf("%s/%s", url, blobName)
+	}
+	d.Set("url", fmt.Sprintf("%s%s", url, sas))
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAzureRMStorageBlobSas_blob(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_blob_sas.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccDataSourceAzureRMStorageBlobSas_blob(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("sr=b&")),
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("sp=r&")),
+					resource.TestMatchResourceAttr(dataSourceName, "url", regexpMustContain("/vhds/herpderp1.vhd?")),
+					testCheckAzureRMStorageSASURLStatusCode(dataSourceName, http.StatusOK),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMStorageBlobSas_container(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_blob_sas.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccDataSourceAzureRMStorageBlobSas_container(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("sr=c&")),
+					resource.TestMatchResourceAttr(dataSourceName, "sas", regexpMustContain("sp=rl&")),
+					resource.TestMatchResourceAttr(dataSourceName, "url", regexpMustContain("/vhds?")),
+				),
+			},
+		},
+	})
+}
+
+// testCheckAzureRMStorageSASURLStatusCode requests the `url` exported by the data source, to confirm
+// the Shared Access Signature is accepted by the Storage Service
+func testCheckAzureRMStorageSASURLStatusCode(name string, statusCode int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resp, err := http.Head(rs.Primary.Attributes["url"])
+		if err != nil {
+			return fmt.Errorf("Error requesting the SAS URL: %+v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != statusCode {
+			return fmt.Errorf("Expected a %d status code for the SAS URL but got %d", statusCode, resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+func regexpMustContain(value string) *regexp.Regexp {
+	return regexp.MustCompile(regexp.QuoteMeta(value))
+}
+
+func testAccDataSourceAzureRMStorageBlobSas_blob(rInt int, rString string, location string) string {
+	resource := testAccAzureRMStorageBlob_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_sas" "test" {
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  resource_group_name  = "${azurerm_storage_account.test.resource_group_name}"
+  container_name       = "${azurerm_storage_container.test.name}"
+  blob_name            = "${azurerm_storage_blob.test.name}"
+  expiry               = "2099-03-21T00:00:00Z"
+
+  permissions {
+    read = true
+  }
+}
+`, resource)
+}
+
+func testAccDataSourceAzureRMStorageBlobSas_container(rInt int, rString string, location string) string {
+	resource := testAccAzureRMStorageContainer_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_sas" "test" {
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  resource_group_name  = "${azurerm_storage_account.test.resource_group_name}"
+  container_name       = "${azurerm_storage_container.test.name}"
+  start                = "2017-03-21T00:00:00Z"
+  expiry               = "2099-03-21T00:00:00Z"
+  ip_range             = "0.0.0.0-255.255.255.255"
+
+  permissions {
+    read = true
+    list = true
+  }
+}
+`, resource)
+}
@@ -0,0 +1,73 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmSubnet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSubnetRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"virtual_network_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"address_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_configurations": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subnetClient
+
+	resGroup := d.Get("resource_group_name").(string)
+	vnetName := d.Get("virtual_network_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := client.Get(resGroup, vnetName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Subnet %q (Virtual Network %q / Resource Group %q) was not found", name, vnetName, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return flattenAndSetSubnet(d, resp)
+}
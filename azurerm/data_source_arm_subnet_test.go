@@ -0,0 +1,76 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSubnet_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_subnet.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMSubnet_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "acctestsubnet"),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_network_name", fmt.Sprintf("acctestvirtnet%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "address_prefix", "10.0.2.0/24"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_security_group_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "route_table_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSubnet_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                      = "acctestsubnet"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  virtual_network_name      = "${azurerm_virtual_network.test.name}"
+  address_prefix            = "10.0.2.0/24"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  route_table_id            = "${azurerm_route_table.test.id}"
+}
+
+data "azurerm_subnet" "test" {
+  name                 = "${azurerm_subnet.test.name}"
+  virtual_network_name = "${azurerm_subnet.test.virtual_network_name}"
+  resource_group_name  = "${azurerm_subnet.test.resource_group_name}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
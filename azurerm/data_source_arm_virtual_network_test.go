@@ -0,0 +1,66 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualNetwork_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_network.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMVirtualNetwork_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", fmt.Sprintf("acctestvirtnet%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "address_space.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "address_space.0", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "dns_servers.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualNetwork_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  dns_servers         = ["10.0.0.4", "10.0.0.5"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    name           = "subnet1"
+    address_prefix = "10.0.1.0/24"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+
+data "azurerm_virtual_network" "test" {
+  name                = "${azurerm_virtual_network.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/satori/uuid"
+)
+
+func TestAccAzureRMAzureADServicePrincipalPassword_importBasic(t *testing.T) {
+	resourceName := "azurerm_azuread_service_principal_password.test"
+
+	id := acctest.RandString(8)
+	value := uuid.NewV4().String()
+	config := testAccAzureRMAzureADServicePrincipalPassword_basic(id, value)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the value isn't returned from the API
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
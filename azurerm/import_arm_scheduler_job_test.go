@@ -0,0 +1,60 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMSchedulerJob_importWeb(t *testing.T) {
+	resourceName := "azurerm_scheduler_job.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMSchedulerJob_webComplete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSchedulerJob_importStorageQueue(t *testing.T) {
+	resourceName := "azurerm_scheduler_job.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMSchedulerJob_storageQueue(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the SAS Token isn't returned from the API
+				ImportStateVerifyIgnore: []string{
+					"action_storage_queue.0.sas_token",
+					"error_action_storage_queue.0.sas_token",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Backend Address Pools, Probes, Rules and NAT Rules/Pools of a Load Balancer are only available
+// as properties of the Load Balancer - as such each change to one of these requires a GET/PUT of the
+// Load Balancer. Since these PUT's are serialized, a change is applied as soon as no other update of the
+// same Load Balancer is in progress - and changes submitted whilst an update is in progress are coalesced
+// into the next update of the Load Balancer.
+
+// loadBalancerChange is a change to a child resource of a Load Balancer
+type loadBalancerChange struct {
+	// description identifies the child resource, for error attribution, e.g. `Probe "example"`
+	description string
+
+	// apply makes the change to the Load Balancer - it may be called more than once
+	apply func(lb *network.LoadBalancer) error
+
+	err  error
+	done chan struct{}
+}
+
+type loadBalancerBatch struct {
+	meta    interface{}
+	changes []*loadBalancerChange
+}
+
+type loadBalancerBatcher struct {
+	get    func(id string, meta interface{}) (*network.LoadBalancer, bool, error)
+	update func(id string, lb *network.LoadBalancer, meta interface{}) error
+
+	mutex sync.Mutex
+	// pending contains the changes which are waiting to be applied to each Load Balancer
+	pending map[string]*loadBalancerBatch
+	// processing tracks the Load Balancers which changes are currently being applied to
+	processing map[string]bool
+}
+
+var loadBalancerChanges = &loadBalancerBatcher{
+	get:        retrieveLoadBalancerById,
+	update:     updateLoadBalancer,
+	pending:    make(map[string]*loadBalancerBatch),
+	processing: make(map[string]bool),
+}
+
+// applyLoadBalancerChange applies a change to a child resource of the Load Balancer, batching
+// it with any other pending changes to the same Load Balancer. The error returned (if any) is
+// specific to this change.
+func applyLoadBalancerChange(loadBalancerId string, description string, meta interface{}, apply func(lb *network.LoadBalancer) error) error {
+	return loadBalancerChanges.submit(loadBalancerId, description, meta, apply)
+}
+
+func (b *loadBalancerBatcher) submit(loadBalancerId string, description string, meta interface{}, apply func(lb *network.LoadBalancer) error) error {
+	change := &loadBalancerChange{
+		description: description,
+		apply:       apply,
+		done:        make(chan struct{}),
+	}
+
+	key := azureRMLockKey(loadBalancerId)
+
+	b.mutex.Lock()
+	batch, exists := b.pending[key]
+	if !exists {
+		batch = &loadBalancerBatch{
+			meta: meta,
+		}
+		b.pending[key] = batch
+	}
+	batch.changes = append(batch.changes, change)
+	if !b.processing[key] {
+		b.processing[key] = true
+		go b.process(loadBalancerId, key)
+	}
+	b.mutex.Unlock()
+
+	<-change.done
+	return change.err
+}
+
+// process applies the pending changes to the Load Balancer until there are none left
+func (b *loadBalancerBatcher) process(loadBalancerId string, key string) {
+	for {
+		// changes continue to be added to the batch whilst we're waiting for the lock
+		azureRMLockByID(loadBalancerId)
+
+		b.mutex.Lock()
+		batch, exists := b.pending[key]
+		delete(b.pending, key)
+		if !exists {
+			delete(b.processing, key)
+		}
+		b.mutex.Unlock()
+
+		if exists {
+			b.applyBatch(loadBalancerId, batch)
+		}
+
+		azureRMUnlockByID(loadBalancerId)
+
+		if !exists {
+			return
+		}
+	}
+}
+
+func (b *loadBalancerBatcher) applyBatch(loadBalancerId string, batch *loadBalancerBatch) {
+	log.Printf("[DEBUG] Applying %d change(s) to LoadBalancer %q", len(batch.changes), loadBalancerId)
+	err := b.applyChanges(loadBalancerId, batch.meta, batch.changes)
+	if err != nil {
+		if len(batch.changes) == 1 {
+			batch.changes[0].err = err
+		} else {
+			// we can't tell which change caused the update to fail - so retry them individually
+			log.Printf("[DEBUG] Error applying %d change(s) to LoadBalancer %q - retrying individually: %+v", len(batch.changes), loadBalancerId, err)
+			for _, change := range batch.changes {
+				if change.err != nil {
+					continue
+				}
+
+				changes := []*loadBalancerChange{change}
+				if err := b.applyChanges(loadBalancerId, batch.meta, changes); err != nil {
+					change.err = err
+				}
+			}
+		}
+	}
+
+	for _, change := range batch.changes {
+		close(change.done)
+	}
+}
+
+// applyChanges applies the changes to the Load Balancer in a single update. Errors specific to a
+// change are set on the change - and an error is only returned if the update itself failed.
+func (b *loadBalancerBatcher) applyChanges(loadBalancerId string, meta interface{}, changes []*loadBalancerChange) error {
+	loadBalancer, exists, err := b.get(loadBalancerId, meta)
+	if err != nil || !exists {
+		if err == nil {
+			err = fmt.Errorf("LoadBalancer %q was not found", loadBalancerId)
+		}
+
+		for _, change := range changes {
+			change.err = fmt.Errorf("Error Getting LoadBalancer for %s: %+v", change.description, err)
+		}
+		return nil
+	}
+
+	applied := 0
+	for _, change := range changes {
+		if err := change.apply(loadBalancer); err != nil {
+			change.err = fmt.Errorf("Error applying %s to LoadBalancer: %+v", change.description, err)
+			continue
+		}
+		applied++
+	}
+
+	if applied == 0 {
+		return nil
+	}
+
+	err = b.update(loadBalancerId, loadBalancer, meta)
+	if err != nil && len(changes) == 1 {
+		return fmt.Errorf("Error Creating/Updating LoadBalancer for %s: %+v", changes[0].description, err)
+	}
+
+	return err
+}
+
+func updateLoadBalancer(loadBalancerId string, loadBalancer *network.LoadBalancer, meta interface{}) error {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerId)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
+	}
+
+	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
+	err = <-error
+	if err != nil {
+		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Accepted", "Updating"},
+		Target:  []string{"Succeeded"},
+		Refresh: loadbalancerStateRefreshFunc(client, resGroup, loadBalancerName),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const testLoadBalancerID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1"
+
+type testLoadBalancerBatcher struct {
+	*loadBalancerBatcher
+
+	updates     int
+	updateMutex sync.Mutex
+	probes      []network.Probe
+
+	// if set, each update waits for a value to be sent on this channel
+	proceed chan struct{}
+}
+
+func newTestLoadBalancerBatcher(failWithProbe string) *testLoadBalancerBatcher {
+	b := &testLoadBalancerBatcher{}
+	b.loadBalancerBatcher = &loadBalancerBatcher{
+		get: func(id string, meta interface{}) (*network.LoadBalancer, bool, error) {
+			b.updateMutex.Lock()
+			defer b.updateMutex.Unlock()
+
+			probes := make([]network.Probe, len(b.probes))
+			copy(probes, b.probes)
+			return &network.LoadBalancer{
+				LoadBalancerPropertiesFormat: &network.LoadBalancerPropertiesFormat{
+					Probes: &probes,
+				},
+			}, true, nil
+		},
+		update: func(id string, lb *network.LoadBalancer, meta interface{}) error {
+			if b.proceed != nil {
+				<-b.proceed
+			}
+
+			b.updateMutex.Lock()
+			defer b.updateMutex.Unlock()
+
+			b.updates++
+			for _, probe := range *lb.LoadBalancerPropertiesFormat.Probes {
+				if *probe.Name == failWithProbe {
+					return fmt.Errorf("Probe %q is invalid", failWithProbe)
+				}
+			}
+
+			b.probes = *lb.LoadBalancerPropertiesFormat.Probes
+			return nil
+		},
+		pending:    make(map[string]*loadBalancerBatch),
+		processing: make(map[string]bool),
+	}
+	return b
+}
+
+// waitForPendingChanges waits until changes are being applied to the Load Balancer, and the expected
+// number of changes are waiting to be applied
+func (b *testLoadBalancerBatcher) waitForPendingChanges(t *testing.T, expected int) {
+	key := azureRMLockKey(testLoadBalancerID)
+	for i := 0; i < 100; i++ {
+		b.mutex.Lock()
+		processing := b.processing[key]
+		pending := 0
+		if batch, ok := b.pending[key]; ok {
+			pending = len(batch.changes)
+		}
+		b.mutex.Unlock()
+
+		if processing && pending == expected {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("Timed out waiting for %d changes to be pending", expected)
+}
+
+func testLoadBalancerAddProbe(name string) func(lb *network.LoadBalancer) error {
+	return func(lb *network.LoadBalancer) error {
+		probes := append(*lb.LoadBalancerPropertiesFormat.Probes, network.Probe{
+			Name: utils.String(name),
+		})
+		lb.LoadBalancerPropertiesFormat.Probes = &probes
+		return nil
+	}
+}
+
+// testLoadBalancerSubmitProbes submits the changes whilst the Load Balancer is locked (as if it was being
+// updated elsewhere) - such that they're all pending when the Load Balancer becomes available
+func testLoadBalancerSubmitProbes(t *testing.T, b *testLoadBalancerBatcher, names []string, apply func(name string) func(lb *network.LoadBalancer) error) map[string]error {
+	azureRMLockByID(testLoadBalancerID)
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	errors := make(map[string]error)
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			err := b.submit(testLoadBalancerID, fmt.Sprintf("Probe %q", name), nil, apply(name))
+
+			mutex.Lock()
+			errors[name] = err
+			mutex.Unlock()
+		}(name)
+	}
+
+	b.waitForPendingChanges(t, len(names))
+	azureRMUnlockByID(testLoadBalancerID)
+	wg.Wait()
+
+	return errors
+}
+
+func TestLoadBalancerBatcher_coalescesChanges(t *testing.T) {
+	b := newTestLoadBalancerBatcher("")
+	names := []string{"probe1", "probe2", "probe3", "probe4", "probe5"}
+
+	errors := testLoadBalancerSubmitProbes(t, b, names, testLoadBalancerAddProbe)
+	for name, err := range errors {
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", name, err)
+		}
+	}
+
+	if b.updates != 1 {
+		t.Fatalf("Expected the Load Balancer to be updated once but was updated %d times", b.updates)
+	}
+
+	if len(b.probes) != len(names) {
+		t.Fatalf("Expected %d probes but got %d", len(names), len(b.probes))
+	}
+}
+
+func TestLoadBalancerBatcher_attributesApplyErrors(t *testing.T) {
+	b := newTestLoadBalancerBatcher("")
+	names := []string{"probe1", "invalid", "probe3"}
+
+	errors := testLoadBalancerSubmitProbes(t, b, names, func(name string) func(lb *network.LoadBalancer) error {
+		if name == "invalid" {
+			return func(lb *network.LoadBalancer) error {
+				return fmt.Errorf("the frontend IP configuration doesn't exist")
+			}
+		}
+		return testLoadBalancerAddProbe(name)
+	})
+
+	if err := errors["invalid"]; err == nil || !strings.Contains(err.Error(), `Probe "invalid"`) {
+		t.Fatalf("Expected an error for Probe %q but got: %+v", "invalid", err)
+	}
+	for _, name := range []string{"probe1", "probe3"} {
+		if err := errors[name]; err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", name, err)
+		}
+	}
+
+	if b.updates != 1 {
+		t.Fatalf("Expected the Load Balancer to be updated once but was updated %d times", b.updates)
+	}
+}
+
+func TestLoadBalancerBatcher_attributesUpdateErrors(t *testing.T) {
+	b := newTestLoadBalancerBatcher("rejected")
+	names := []string{"probe1", "rejected", "probe3"}
+
+	errors := testLoadBalancerSubmitProbes(t, b, names, testLoadBalancerAddProbe)
+
+	if err := errors["rejected"]; err == nil || !strings.Contains(err.Error(), `Probe "rejected"`) {
+		t.Fatalf("Expected an error for Probe %q but got: %+v", "rejected", err)
+	}
+	for _, name := range []string{"probe1", "probe3"} {
+		if err := errors[name]; err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", name, err)
+		}
+	}
+
+	// the failed batch, followed by each change individually
+	if expected := 1 + len(names); b.updates != expected {
+		t.Fatalf("Expected the Load Balancer to be updated %d times but was updated %d times", expected, b.updates)
+	}
+
+	if len(b.probes) != 2 {
+		t.Fatalf("Expected 2 probes but got %d", len(b.probes))
+	}
+}
+
+func TestLoadBalancerBatcher_appliesChangeImmediately(t *testing.T) {
+	b := newTestLoadBalancerBatcher("")
+
+	done := make(chan error)
+	go func() {
+		done <- b.submit(testLoadBalancerID, `Probe "probe1"`, nil, testLoadBalancerAddProbe("probe1"))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Timed out waiting for the change to be applied")
+	}
+
+	if b.updates != 1 {
+		t.Fatalf("Expected the Load Balancer to be updated once but was updated %d times", b.updates)
+	}
+}
+
+func TestLoadBalancerBatcher_coalescesChangesSubmittedDuringUpdate(t *testing.T) {
+	b := newTestLoadBalancerBatcher("")
+	b.proceed = make(chan struct{})
+
+	var wg sync.WaitGroup
+	submit := func(name string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := b.submit(testLoadBalancerID, fmt.Sprintf("Probe %q", name), nil, testLoadBalancerAddProbe(name)); err != nil {
+				t.Errorf("Expected no error for %q but got: %+v", name, err)
+			}
+		}()
+	}
+
+	// the first change is applied straight away - and the update blocks until we proceed
+	submit("probe1")
+	b.waitForPendingChanges(t, 0)
+
+	names := []string{"probe2", "probe3", "probe4"}
+	for _, name := range names {
+		submit(name)
+	}
+	b.waitForPendingChanges(t, len(names))
+
+	b.proceed <- struct{}{}
+	b.proceed <- struct{}{}
+	wg.Wait()
+
+	if b.updates != 2 {
+		t.Fatalf("Expected the Load Balancer to be updated twice but was updated %d times", b.updates)
+	}
+
+	if len(b.probes) != 1+len(names) {
+		t.Fatalf("Expected %d probes but got %d", 1+len(names), len(b.probes))
+	}
+}
@@ -1,24 +1,57 @@
 package azurerm
 
-// handle the case of using the same name for different kinds of resources
-func azureRMLockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+import (
+	"sort"
+	"strings"
+)
+
+// azureRMLockByID locks on the Resource ID of the resource being modified.
+// Azure Resource ID's are case-insensitive, so the key is normalized - which also
+// means resources with the same name in different Resource Groups or Subscriptions
+// no longer serialize one another.
+func azureRMLockByID(id string) {
+	armMutexKV.Lock(azureRMLockKey(id))
+}
+
+func azureRMUnlockByID(id string) {
+	armMutexKV.Unlock(azureRMLockKey(id))
+}
+
+// azureRMLockMultipleByID locks each of the specified Resource ID's in a
+// deterministic (sorted) order, ignoring duplicates - such that two resources
+// locking an overlapping set of ID's can't deadlock one another. All of the ID's
+// required by an operation should be locked in a single call to this function.
+func azureRMLockMultipleByID(ids []string) {
+	for _, key := range azureRMLockKeys(ids) {
+		armMutexKV.Lock(key)
+	}
 }
 
-func azureRMLockMultipleByName(names *[]string, resourceType string) {
-	for _, name := range *names {
-		azureRMLockByName(name, resourceType)
+func azureRMUnlockMultipleByID(ids []string) {
+	keys := azureRMLockKeys(ids)
+	for i := len(keys) - 1; i >= 0; i-- {
+		armMutexKV.Unlock(keys[i])
 	}
 }
 
-func azureRMUnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+func azureRMLockKey(id string) string {
+	key := strings.ToLower(strings.TrimSpace(id))
+	return strings.TrimSuffix(key, "/")
 }
 
-func azureRMUnlockMultipleByName(names *[]string, resourceType string) {
-	for _, name := range *names {
-		azureRMUnlockByName(name, resourceType)
+func azureRMLockKeys(ids []string) []string {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+
+		key := azureRMLockKey(id)
+		if !sliceContainsValue(keys, key) {
+			keys = append(keys, key)
+		}
 	}
+
+	sort.Strings(keys)
+	return keys
 }
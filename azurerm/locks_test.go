@@ -0,0 +1,147 @@
+package azurerm
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAzureRMLockKeys(t *testing.T) {
+	cases := []struct {
+		Input    []string
+		Expected []string
+	}{
+		{
+			Input:    []string{},
+			Expected: []string{},
+		},
+		{
+			Input:    []string{""},
+			Expected: []string{},
+		},
+		{
+			Input: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/",
+			},
+			Expected: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1",
+			},
+		},
+		{
+			Input: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/GROUP2/providers/microsoft.network/networksecuritygroups/NSG1",
+			},
+			Expected: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/networksecuritygroups/nsg1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group2/providers/microsoft.network/networksecuritygroups/nsg1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := azureRMLockKeys(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAzureRMLockByID_caseInsensitive(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1"
+	differentCase := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Network/networkSecurityGroups/NSG1"
+
+	azureRMLockByID(id)
+
+	locked := make(chan struct{})
+	go func() {
+		azureRMLockByID(differentCase)
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatalf("Expected the lock on %q to block whilst %q is held", differentCase, id)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	azureRMUnlockByID(id)
+
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the lock on %q", differentCase)
+	}
+
+	azureRMUnlockByID(differentCase)
+}
+
+func TestAzureRMLockByID_sameNameDifferentResourceGroup(t *testing.T) {
+	first := networkResourceID("00000000-0000-0000-0000-000000000000", "group1", "virtualNetworks", "network1")
+	second := networkResourceID("00000000-0000-0000-0000-000000000000", "group2", "virtualNetworks", "network1")
+
+	azureRMLockByID(first)
+	defer azureRMUnlockByID(first)
+
+	locked := make(chan struct{})
+	go func() {
+		azureRMLockByID(second)
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		azureRMUnlockByID(second)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected %q not to be blocked by %q", second, first)
+	}
+}
+
+func TestAzureRMLockMultipleByID_noDeadlock(t *testing.T) {
+	ids := []string{
+		networkResourceID("00000000-0000-0000-0000-000000000000", "group1", "virtualNetworks", "network1"),
+		networkResourceID("00000000-0000-0000-0000-000000000000", "group1", "networkSecurityGroups", "nsg1"),
+		networkResourceID("00000000-0000-0000-0000-000000000000", "group1", "routeTables", "table1"),
+	}
+
+	// each goroutine locks the same ID's in a different order (including duplicates)
+	orderings := [][]string{
+		{ids[0], ids[1], ids[2]},
+		{ids[2], ids[1], ids[0]},
+		{ids[1], ids[2], ids[0], ids[1]},
+		{ids[2], ids[0], ids[2]},
+	}
+
+	var wg sync.WaitGroup
+	counter := 0
+	for i := 0; i < 25; i++ {
+		for _, ordering := range orderings {
+			wg.Add(1)
+			go func(toLock []string) {
+				defer wg.Done()
+				azureRMLockMultipleByID(toLock)
+				defer azureRMUnlockMultipleByID(toLock)
+
+				counter++
+			}(ordering)
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("Timed out waiting for the locks - this suggests a deadlock")
+	}
+
+	if expected := 25 * len(orderings); counter != expected {
+		t.Fatalf("Expected the counter to be %d but got %d", expected, counter)
+	}
+}
@@ -0,0 +1,50 @@
+package azurerm
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Network Security Groups, Route Tables and Virtual Networks allow their children (Security Rules,
+// Routes and Subnets) to be managed either inline within the parent, or via a standalone resource.
+// Inline blocks are only authoritative when they're specified - when they're omitted (or unchanged)
+// the children which currently exist on the parent are retained, so they can be managed elsewhere.
+
+// inlineChildrenAreAuthoritative returns whether the inline blocks in the field `key` should
+// replace the children of the parent resource.
+func inlineChildrenAreAuthoritative(d *schema.ResourceData, key string) bool {
+	return d.IsNewResource() || d.HasChange(key)
+}
+
+// inlineChildrenToBeRemoved returns the names of the children which exist on the parent
+// but which aren't defined in the inline blocks in the field `key`.
+func inlineChildrenToBeRemoved(d *schema.ResourceData, key string) []string {
+	old, new := d.GetChange(key)
+
+	configured := make([]string, 0)
+	for _, v := range new.(*schema.Set).List() {
+		configured = append(configured, v.(map[string]interface{})["name"].(string))
+	}
+
+	removed := make([]string, 0)
+	for _, v := range old.(*schema.Set).List() {
+		name := v.(map[string]interface{})["name"].(string)
+		if !sliceContainsValue(configured, name) && !sliceContainsValue(removed, name) {
+			removed = append(removed, name)
+		}
+	}
+
+	return removed
+}
+
+// logInlineChildrenToBeRemoved logs each child which'll be removed from the parent since it's not
+// defined inline - as if it's managed by the standalone resource the two will conflict, with each
+// apply removing the other's children. This is only logged during the apply, since the vendored
+// version of helper/schema doesn't support CustomizeDiff, so this can't be surfaced in the plan.
+func logInlineChildrenToBeRemoved(d *schema.ResourceData, key string, standaloneResourceType string) {
+	for _, name := range inlineChildrenToBeRemoved(d, key) {
+		log.Printf("[WARN] %q (in %q) isn't defined in the inline `%s` blocks and will be removed. If this is managed by a %q resource, the inline `%s` blocks should be removed from the parent resource to allow it to be managed standalone.",
+			name, d.Get("name").(string), key, standaloneResourceType, key)
+	}
+}
@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// The Network Interface Association resources link an IP Configuration of a Network Interface to another
+// resource (such as a Load Balancer Backend Address Pool) - and have a composite ID in the format:
+// `{networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{associatedResourceId}`
+
+// networkInterfaceAssociation describes the type of resource which is associated with the IP Configuration,
+// since the Create/Read/Delete logic is otherwise the same for each of the Network Interface Association resources
+type networkInterfaceAssociation struct {
+	// the name of the field containing the ID of the associated resource, e.g. `backend_address_pool_id`
+	field string
+
+	// the name of the associated resource type used in log/error messages, e.g. `Backend Address Pool`
+	displayName string
+
+	// getIDs returns the IDs of the resources of this type which are associated with the IP Configuration
+	getIDs func(props *network.InterfaceIPConfigurationPropertiesFormat) []string
+
+	// setIDs replaces the resources of this type which are associated with the IP Configuration
+	setIDs func(props *network.InterfaceIPConfigurationPropertiesFormat, ids []string)
+}
+
+func (a networkInterfaceAssociation) resource() *schema.Resource {
+	return &schema.Resource{
+		Create: a.create,
+		Read:   a.read,
+		Delete: a.delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			a.field: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func (a networkInterfaceAssociation) create(d *schema.ResourceData, meta interface{}) error {
+	networkInterfaceId := d.Get("network_interface_id").(string)
+	ipConfigurationName := d.Get("ip_configuration_name").(string)
+	associatedId := d.Get(a.field).(string)
+
+	err := updateNetworkInterfaceIPConfiguration(networkInterfaceId, ipConfigurationName, meta, func(props *network.InterfaceIPConfigurationPropertiesFormat) {
+		ids := removeNetworkInterfaceAssociatedID(a.getIDs(props), associatedId)
+		a.setIDs(props, append(ids, associatedId))
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(composeNetworkInterfaceAssociationID(networkInterfaceId, ipConfigurationName, associatedId))
+
+	return a.read(d, meta)
+}
+
+func (a networkInterfaceAssociation) read(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseNetworkInterfaceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	config, exists, err := retrieveNetworkInterfaceIPConfiguration(id.NetworkInterfaceID, id.IPConfigurationName, meta)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		log.Printf("[INFO] IP Configuration %q of Network Interface %q was not found. Removing from state", id.IPConfigurationName, id.NetworkInterfaceName)
+		d.SetId("")
+		return nil
+	}
+
+	found := false
+	if props := config.InterfaceIPConfigurationPropertiesFormat; props != nil {
+		for _, associatedId := range a.getIDs(props) {
+			if strings.EqualFold(associatedId, id.AssociatedID) {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[INFO] %s %q is not associated with Network Interface %q. Removing from state", a.displayName, id.AssociatedID, id.NetworkInterfaceName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", id.NetworkInterfaceID)
+	d.Set("ip_configuration_name", id.IPConfigurationName)
+	d.Set(a.field, id.AssociatedID)
+
+	return nil
+}
+
+func (a networkInterfaceAssociation) delete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseNetworkInterfaceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, exists, err := retrieveNetworkInterfaceIPConfiguration(id.NetworkInterfaceID, id.IPConfigurationName, meta)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	err = updateNetworkInterfaceIPConfiguration(id.NetworkInterfaceID, id.IPConfigurationName, meta, func(props *network.InterfaceIPConfigurationPropertiesFormat) {
+		a.setIDs(props, removeNetworkInterfaceAssociatedID(a.getIDs(props), id.AssociatedID))
+	})
+	if err != nil {
+		return fmt.Errorf("Error removing %s Association for Network Interface %q (Resource Group %q): %+v", a.displayName, id.NetworkInterfaceName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func removeNetworkInterfaceAssociatedID(input []string, associatedId string) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		if strings.EqualFold(v, associatedId) {
+			continue
+		}
+		output = append(output, v)
+	}
+	return output
+}
+
+type networkInterfaceAssociationID struct {
+	NetworkInterfaceID   string
+	ResourceGroup        string
+	NetworkInterfaceName string
+	IPConfigurationName  string
+	AssociatedID         string
+}
+
+func parseNetworkInterfaceAssociationID(input string) (*networkInterfaceAssociationID, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Expected an ID in the format `{networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{associatedResourceId}` but got %q", input)
+	}
+
+	ipConfigurationId := segments[0]
+	id, err := parseAzureResourceID(ipConfigurationId)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface IP Configuration ID %q: %+v", ipConfigurationId, err)
+	}
+
+	networkInterfaceName := id.Path["networkInterfaces"]
+	ipConfigurationName := id.Path["ipConfigurations"]
+	if networkInterfaceName == "" || ipConfigurationName == "" {
+		return nil, fmt.Errorf("Expected %q to be the ID of a Network Interface IP Configuration", ipConfigurationId)
+	}
+
+	if _, err := parseAzureResourceID(segments[1]); err != nil {
+		return nil, fmt.Errorf("Error parsing Associated Resource ID %q: %+v", segments[1], err)
+	}
+
+	return &networkInterfaceAssociationID{
+		NetworkInterfaceID:   strings.TrimSuffix(ipConfigurationId, fmt.Sprintf("/ipConfigurations/%s", ipConfigurationName)),
+		ResourceGroup:        id.ResourceGroup,
+		NetworkInterfaceName: networkInterfaceName,
+		IPConfigurationName:  ipConfigurationName,
+		AssociatedID:         segments[1],
+	}, nil
+}
+
+func composeNetworkInterfaceAssociationID(networkInterfaceId string, ipConfigurationName string, associatedId string) string {
+	return fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceId, ipConfigurationName, associatedId)
+}
+
+// retrieveNetworkInterfaceIPConfiguration returns the named IP Configuration of the Network Interface,
+// and whether both the Network Interface and the IP Configuration exist
+func retrieveNetworkInterfaceIPConfiguration(networkInterfaceId string, ipConfigurationName string, meta interface{}) (*network.InterfaceIPConfiguration, bool, error) {
+	client := meta.(*ArmClient).ifaceClient
+
+	id, err := parseAzureResourceID(networkInterfaceId)
+	if err != nil {
+		return nil, false, err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["networkInterfaces"]
+
+	resp, err := client.Get(resGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	config := findNetworkInterfaceIPConfigurationByName(&resp, ipConfigurationName)
+	return config, config != nil, nil
+}
+
+// updateNetworkInterfaceIPConfiguration retrieves the Network Interface and updates only the named IP
+// Configuration, whilst holding a lock on the Network Interface
+func updateNetworkInterfaceIPConfiguration(networkInterfaceId string, ipConfigurationName string, meta interface{}, update func(props *network.InterfaceIPConfigurationPropertiesFormat)) error {
+	client := meta.(*ArmClient).ifaceClient
+
+	id, err := parseAzureResourceID(networkInterfaceId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["networkInterfaces"]
+
+	azureRMLockByID(networkInterfaceId)
+	defer azureRMUnlockByID(networkInterfaceId)
+
+	iface, err := client.Get(resGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	config := findNetworkInterfaceIPConfigurationByName(&iface, ipConfigurationName)
+	if config == nil {
+		return fmt.Errorf("IP Configuration %q was not found on Network Interface %q (Resource Group %q)", ipConfigurationName, name, resGroup)
+	}
+
+	if config.InterfaceIPConfigurationPropertiesFormat == nil {
+		config.InterfaceIPConfigurationPropertiesFormat = &network.InterfaceIPConfigurationPropertiesFormat{}
+	}
+	update(config.InterfaceIPConfigurationPropertiesFormat)
+
+	_, error := client.CreateOrUpdate(resGroup, name, iface, make(chan struct{}))
+	err = <-error
+	if err != nil {
+		return fmt.Errorf("Error updating IP Configuration %q of Network Interface %q (Resource Group %q): %+v", ipConfigurationName, name, resGroup, err)
+	}
+
+	return nil
+}
+
+func findNetworkInterfaceIPConfigurationByName(iface *network.Interface, name string) *network.InterfaceIPConfiguration {
+	if iface.InterfacePropertiesFormat == nil || iface.InterfacePropertiesFormat.IPConfigurations == nil {
+		return nil
+	}
+
+	configs := *iface.InterfacePropertiesFormat.IPConfigurations
+	for i := range configs {
+		if configs[i].Name != nil && *configs[i].Name == name {
+			return &configs[i]
+		}
+	}
+
+	return nil
+}
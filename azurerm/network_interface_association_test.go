@@ -0,0 +1,64 @@
+package azurerm
+
+import "testing"
+
+func TestParseNetworkInterfaceAssociationID(t *testing.T) {
+	networkInterfaceId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1"
+	poolId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"
+
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       networkInterfaceId,
+			ExpectError: true,
+		},
+		{
+			Input:       networkInterfaceId + "|" + poolId,
+			ExpectError: true,
+		},
+		{
+			Input:       networkInterfaceId + "/ipConfigurations/config1|",
+			ExpectError: true,
+		},
+		{
+			Input:       networkInterfaceId + "/ipConfigurations/config1|" + poolId + "|" + poolId,
+			ExpectError: true,
+		},
+		{
+			Input:       composeNetworkInterfaceAssociationID(networkInterfaceId, "config1", poolId),
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := parseNetworkInterfaceAssociationID(tc.Input)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", tc.Input, err)
+		}
+
+		if id.NetworkInterfaceID != networkInterfaceId {
+			t.Fatalf("Expected the Network Interface ID to be %q but got %q", networkInterfaceId, id.NetworkInterfaceID)
+		}
+		if id.ResourceGroup != "group1" {
+			t.Fatalf("Expected the Resource Group to be %q but got %q", "group1", id.ResourceGroup)
+		}
+		if id.NetworkInterfaceName != "nic1" {
+			t.Fatalf("Expected the Network Interface Name to be %q but got %q", "nic1", id.NetworkInterfaceName)
+		}
+		if id.IPConfigurationName != "config1" {
+			t.Fatalf("Expected the IP Configuration Name to be %q but got %q", "config1", id.IPConfigurationName)
+		}
+		if id.AssociatedID != poolId {
+			t.Fatalf("Expected the Associated ID to be %q but got %q", poolId, id.AssociatedID)
+		}
+	}
+}
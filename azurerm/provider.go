@@ -8,6 +8,7 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/hashicorp/go-multierror"
@@ -59,84 +60,124 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"azurerm_client_config":  dataSourceArmClientConfig(),
-			"azurerm_resource_group": dataSourceArmResourceGroup(),
-			"azurerm_public_ip":      dataSourceArmPublicIP(),
-			"azurerm_managed_disk":   dataSourceArmManagedDisk(),
-			"azurerm_subscription":   dataSourceArmSubscription(),
+			"azurerm_client_config":          dataSourceArmClientConfig(),
+			"azurerm_key_vault":              dataSourceArmKeyVault(),
+			"azurerm_key_vault_key":          dataSourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":       dataSourceArmKeyVaultSecret(),
+			"azurerm_resource_group":         dataSourceArmResourceGroup(),
+			"azurerm_image":                  dataSourceArmImage(),
+			"azurerm_platform_image":         dataSourceArmPlatformImage(),
+			"azurerm_public_ip":              dataSourceArmPublicIP(),
+			"azurerm_managed_disk":           dataSourceArmManagedDisk(),
+			"azurerm_managed_disk_sas":       dataSourceArmManagedDiskSharedAccessSignature(),
+			"azurerm_snapshot":               dataSourceArmSnapshot(),
+			"azurerm_subscription":           dataSourceArmSubscription(),
+			"azurerm_virtual_network":        dataSourceArmVirtualNetwork(),
+			"azurerm_storage_account_sas":    dataSourceArmStorageAccountSharedAccessSignature(),
+			"azurerm_storage_blob_sas":       dataSourceArmStorageBlobSharedAccessSignature(),
+			"azurerm_subnet":                 dataSourceArmSubnet(),
+			"azurerm_network_security_group": dataSourceArmNetworkSecurityGroup(),
+			"azurerm_route_table":            dataSourceArmRouteTable(),
+			"azurerm_network_interface":      dataSourceArmNetworkInterface(),
+			"azurerm_lb":                     dataSourceArmLoadBalancer(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurerm_application_insights":        resourceArmApplicationInsights(),
-			"azurerm_app_service_plan":            resourceArmAppServicePlan(),
-			"azurerm_availability_set":            resourceArmAvailabilitySet(),
-			"azurerm_cdn_endpoint":                resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":                 resourceArmCdnProfile(),
-			"azurerm_container_registry":          resourceArmContainerRegistry(),
-			"azurerm_container_service":           resourceArmContainerService(),
-			"azurerm_cosmosdb_account":            resourceArmCosmosDBAccount(),
-			"azurerm_dns_a_record":                resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":             resourceArmDnsAAAARecord(),
-			"azurerm_dns_cname_record":            resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":               resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":               resourceArmDnsNsRecord(),
-			"azurerm_dns_ptr_record":              resourceArmDnsPtrRecord(),
-			"azurerm_dns_srv_record":              resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":              resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":                    resourceArmDnsZone(),
-			"azurerm_eventgrid_topic":             resourceArmEventGridTopic(),
-			"azurerm_eventhub":                    resourceArmEventHub(),
-			"azurerm_eventhub_authorization_rule": resourceArmEventHubAuthorizationRule(),
-			"azurerm_eventhub_consumer_group":     resourceArmEventHubConsumerGroup(),
-			"azurerm_eventhub_namespace":          resourceArmEventHubNamespace(),
-			"azurerm_express_route_circuit":       resourceArmExpressRouteCircuit(),
-			"azurerm_image":                       resourceArmImage(),
-			"azurerm_key_vault":                   resourceArmKeyVault(),
-			"azurerm_key_vault_secret":            resourceArmKeyVaultSecret(),
-			"azurerm_lb":                          resourceArmLoadBalancer(),
-			"azurerm_lb_backend_address_pool":     resourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_lb_nat_rule":                 resourceArmLoadBalancerNatRule(),
-			"azurerm_lb_nat_pool":                 resourceArmLoadBalancerNatPool(),
-			"azurerm_lb_probe":                    resourceArmLoadBalancerProbe(),
-			"azurerm_lb_rule":                     resourceArmLoadBalancerRule(),
-			"azurerm_local_network_gateway":       resourceArmLocalNetworkGateway(),
-			"azurerm_managed_disk":                resourceArmManagedDisk(),
-			"azurerm_network_interface":           resourceArmNetworkInterface(),
-			"azurerm_network_security_group":      resourceArmNetworkSecurityGroup(),
-			"azurerm_network_security_rule":       resourceArmNetworkSecurityRule(),
-			"azurerm_postgresql_configuration":    resourceArmPostgreSQLConfiguration(),
-			"azurerm_postgresql_database":         resourceArmPostgreSQLDatabase(),
-			"azurerm_postgresql_firewall_rule":    resourceArmPostgreSQLFirewallRule(),
-			"azurerm_postgresql_server":           resourceArmPostgreSQLServer(),
-			"azurerm_public_ip":                   resourceArmPublicIp(),
-			"azurerm_redis_cache":                 resourceArmRedisCache(),
-			"azurerm_resource_group":              resourceArmResourceGroup(),
-			"azurerm_route":                       resourceArmRoute(),
-			"azurerm_route_table":                 resourceArmRouteTable(),
-			"azurerm_search_service":              resourceArmSearchService(),
-			"azurerm_servicebus_namespace":        resourceArmServiceBusNamespace(),
-			"azurerm_servicebus_queue":            resourceArmServiceBusQueue(),
-			"azurerm_servicebus_subscription":     resourceArmServiceBusSubscription(),
-			"azurerm_servicebus_topic":            resourceArmServiceBusTopic(),
-			"azurerm_sql_database":                resourceArmSqlDatabase(),
-			"azurerm_sql_elasticpool":             resourceArmSqlElasticPool(),
-			"azurerm_sql_firewall_rule":           resourceArmSqlFirewallRule(),
-			"azurerm_sql_server":                  resourceArmSqlServer(),
-			"azurerm_storage_account":             resourceArmStorageAccount(),
-			"azurerm_storage_blob":                resourceArmStorageBlob(),
-			"azurerm_storage_container":           resourceArmStorageContainer(),
-			"azurerm_storage_share":               resourceArmStorageShare(),
-			"azurerm_storage_queue":               resourceArmStorageQueue(),
-			"azurerm_storage_table":               resourceArmStorageTable(),
-			"azurerm_subnet":                      resourceArmSubnet(),
-			"azurerm_template_deployment":         resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":    resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":     resourceArmTrafficManagerProfile(),
-			"azurerm_virtual_machine_extension":   resourceArmVirtualMachineExtensions(),
-			"azurerm_virtual_machine":             resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_scale_set":   resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":             resourceArmVirtualNetwork(),
-			"azurerm_virtual_network_peering":     resourceArmVirtualNetworkPeering(),
+			"azurerm_application_insights":                    resourceArmApplicationInsights(),
+			"azurerm_app_service":                             resourceArmAppService(),
+			"azurerm_app_service_active_slot":                 resourceArmAppServiceActiveSlot(),
+			"azurerm_app_service_certificate":                 resourceArmAppServiceCertificate(),
+			"azurerm_app_service_custom_hostname_binding":     resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_plan":                        resourceArmAppServicePlan(),
+			"azurerm_app_service_slot":                        resourceArmAppServiceSlot(),
+			"azurerm_app_service_virtual_network_integration": resourceArmAppServiceVirtualNetworkIntegration(),
+			"azurerm_availability_set":                        resourceArmAvailabilitySet(),
+			"azurerm_azuread_application":                     resourceArmAzureADApplication(),
+			"azurerm_azuread_service_principal":               resourceArmAzureADServicePrincipal(),
+			"azurerm_azuread_service_principal_password":      resourceArmAzureADServicePrincipalPassword(),
+			"azurerm_cdn_endpoint":                            resourceArmCdnEndpoint(),
+			"azurerm_cdn_profile":                             resourceArmCdnProfile(),
+			"azurerm_container_registry":                      resourceArmContainerRegistry(),
+			"azurerm_container_service":                       resourceArmContainerService(),
+			"azurerm_cosmosdb_account":                        resourceArmCosmosDBAccount(),
+			"azurerm_dns_a_record":                            resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                         resourceArmDnsAAAARecord(),
+			"azurerm_dns_cname_record":                        resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                           resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                           resourceArmDnsNsRecord(),
+			"azurerm_dns_ptr_record":                          resourceArmDnsPtrRecord(),
+			"azurerm_dns_srv_record":                          resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                          resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                resourceArmDnsZone(),
+			"azurerm_eventgrid_topic":                         resourceArmEventGridTopic(),
+			"azurerm_eventhub":                                resourceArmEventHub(),
+			"azurerm_eventhub_authorization_rule":             resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_consumer_group":                 resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace":                      resourceArmEventHubNamespace(),
+			"azurerm_express_route_circuit":                   resourceArmExpressRouteCircuit(),
+			"azurerm_image":                                   resourceArmImage(),
+			"azurerm_key_vault":                               resourceArmKeyVault(),
+			"azurerm_key_vault_access_policy":                 resourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":                   resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_certificate_contacts":          resourceArmKeyVaultCertificateContacts(),
+			"azurerm_key_vault_certificate_issuer":            resourceArmKeyVaultCertificateIssuer(),
+			"azurerm_key_vault_key":                           resourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                        resourceArmKeyVaultSecret(),
+			"azurerm_lb":                                      resourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool":                 resourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_lb_nat_rule":                             resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_nat_pool":                             resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_probe":                                resourceArmLoadBalancerProbe(),
+			"azurerm_lb_rule":                                 resourceArmLoadBalancerRule(),
+			"azurerm_local_network_gateway":                   resourceArmLocalNetworkGateway(),
+			"azurerm_managed_disk":                            resourceArmManagedDisk(),
+			"azurerm_network_interface":                       resourceArmNetworkInterface(),
+			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
+			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
+			"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
+			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
+			"azurerm_postgresql_configuration":                                               resourceArmPostgreSQLConfiguration(),
+			"azurerm_postgresql_database":                                                    resourceArmPostgreSQLDatabase(),
+			"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
+			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
+			"azurerm_public_ip":                                                              resourceArmPublicIp(),
+			"azurerm_redis_cache":                                                            resourceArmRedisCache(),
+			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
+			"azurerm_route":                                                                  resourceArmRoute(),
+			"azurerm_route_table":                                                            resourceArmRouteTable(),
+			"azurerm_scheduler_job":                                                          resourceArmSchedulerJob(),
+			"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
+			"azurerm_search_service":                                                         resourceArmSearchService(),
+			"azurerm_servicebus_namespace":                                                   resourceArmServiceBusNamespace(),
+			"azurerm_servicebus_queue":                                                       resourceArmServiceBusQueue(),
+			"azurerm_servicebus_subscription":                                                resourceArmServiceBusSubscription(),
+			"azurerm_servicebus_topic":                                                       resourceArmServiceBusTopic(),
+			"azurerm_snapshot":                                                               resourceArmSnapshot(),
+			"azurerm_sql_database":                                                           resourceArmSqlDatabase(),
+			"azurerm_sql_elasticpool":                                                        resourceArmSqlElasticPool(),
+			"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
+			"azurerm_sql_server":                                                             resourceArmSqlServer(),
+			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
+			"azurerm_storage_account_key_rotation":                                           resourceArmStorageAccountKeyRotation(),
+			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
+			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
+			"azurerm_storage_share":                                                          resourceArmStorageShare(),
+			"azurerm_storage_share_directory":                                                resourceArmStorageShareDirectory(),
+			"azurerm_storage_share_file":                                                     resourceArmStorageShareFile(),
+			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
+			"azurerm_storage_table":                                                          resourceArmStorageTable(),
+			"azurerm_storage_table_entity":                                                   resourceArmStorageTableEntity(),
+			"azurerm_subnet":                                                                 resourceArmSubnet(),
+			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
+			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
+			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 		},
 	}
 
@@ -319,6 +360,23 @@ func ignoreCaseDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return strings.ToLower(old) == strings.ToLower(new)
 }
 
+// rfc3339TimeDiffSuppressFunc is a DiffSuppressFunc from helper/schema that is used
+// to ignore differences in the format of RFC3339 dates which represent the same time,
+// such as when the API returns the value in UTC rather than the specified offset.
+func rfc3339TimeDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
 // ignoreCaseStateFunc is a StateFunc from helper/schema that converts the
 // supplied value to lower before saving to state for consistency.
 func ignoreCaseStateFunc(val interface{}) string {
@@ -32,6 +32,41 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestRFC3339TimeDiffSuppressFunc(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "2017-01-01T01:23:45Z",
+			New:      "2017-01-01T01:23:45Z",
+			Suppress: true,
+		},
+		{
+			Old:      "2017-01-01T01:23:45Z",
+			New:      "2017-01-01T02:23:45+01:00",
+			Suppress: true,
+		},
+		{
+			Old:      "2017-01-01T01:23:45Z",
+			New:      "2017-01-01T01:23:45+01:00",
+			Suppress: false,
+		},
+		{
+			Old:      "",
+			New:      "2017-01-01T01:23:45Z",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if rfc3339TimeDiffSuppressFunc("start_time", tc.Old, tc.New, nil) != tc.Suppress {
+			t.Fatalf("Expected rfc3339TimeDiffSuppressFunc to return %t for %q / %q", tc.Suppress, tc.Old, tc.New)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	variables := []string{
 		"ARM_SUBSCRIPTION_ID",
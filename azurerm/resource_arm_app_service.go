@@ -0,0 +1,568 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceCreate,
+		Read:   resourceArmAppServiceRead,
+		Update: resourceArmAppServiceUpdate,
+		Delete: resourceArmAppServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"app_service_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"site_config": appServiceSiteConfigSchema(),
+
+			"app_settings": appServiceAppSettingsSchema(),
+
+			"connection_string": appServiceConnectionStringSchema(),
+
+			"sticky_settings": appServiceStickySettingsSchema(),
+
+			"auth_settings": appServiceAuthSettingsSchema(),
+
+			"backup": appServiceBackupSchema(),
+
+			"logs": appServiceLogsSchema(),
+
+			"source_control": appServiceSourceControlSchema(),
+
+			"client_affinity_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+
+			"default_site_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM App Service creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	site := expandAppService(d)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, site, nil, nil, nil, "")
+	if err != nil {
+		return fmt.Errorf("Error creating App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the creation of App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read App Service %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	if err := updateAppServiceAppSettings(d, meta); err != nil {
+		return err
+	}
+
+	if err := updateAppServiceConnectionStrings(d, meta); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("sticky_settings"); ok {
+		if err := updateAppServiceStickySettings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("auth_settings"); ok {
+		if err := updateAppServiceAuthSettings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("backup"); ok {
+		if err := updateAppServiceBackup(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("logs"); ok {
+		if err := updateAppServiceLogs(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("source_control"); ok {
+		if err := updateAppServiceSourceControl(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmAppServiceRead(d, meta)
+}
+
+func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	if d.HasChange("app_service_plan_id") || d.HasChange("site_config") || d.HasChange("client_affinity_enabled") ||
+		d.HasChange("https_only") || d.HasChange("enabled") || d.HasChange("tags") {
+		site := expandAppService(d)
+
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, site, nil, nil, nil, "")
+		if err != nil {
+			return fmt.Errorf("Error updating App Service %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		err = future.WaitForCompletion(ctx, client.Client)
+		if err != nil {
+			return fmt.Errorf("Error waiting for the update of App Service %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	if d.HasChange("app_settings") {
+		if err := updateAppServiceAppSettings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("connection_string") {
+		if err := updateAppServiceConnectionStrings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("sticky_settings") {
+		if err := updateAppServiceStickySettings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("auth_settings") {
+		if err := updateAppServiceAuthSettings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("backup") {
+		if err := updateAppServiceBackup(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("logs") {
+		if err := updateAppServiceLogs(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("source_control") {
+		if err := updateAppServiceSourceControl(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmAppServiceRead(d, meta)
+}
+
+func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service %q (Resource Group %q) was not found - removing from state", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	configResp, err := client.GetConfiguration(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Configuration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	appSettingsResp, err := client.ListApplicationSettings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Application Settings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	connectionStringsResp, err := client.ListConnectionStrings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Connection Strings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	stickySettingsResp, err := client.ListSlotConfigurationNames(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Sticky Settings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	authSettingsResp, err := client.GetAuthSettings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Authentication Settings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	// a 404 is returned when Backups haven't been configured
+	backupResp, err := client.GetBackupConfiguration(ctx, resGroup, name)
+	if err != nil && !utils.ResponseWasNotFound(backupResp.Response) {
+		return fmt.Errorf("Error retrieving the Backup Configuration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	logsResp, err := client.GetDiagnosticLogsConfiguration(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Diagnostic Logs Configuration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	sourceControlResp, err := client.GetSourceControl(ctx, resGroup, name)
+	if err != nil && !utils.ResponseWasNotFound(sourceControlResp.Response) {
+		return fmt.Errorf("Error retrieving the Source Control for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.SiteProperties; props != nil {
+		d.Set("app_service_plan_id", props.ServerFarmID)
+		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
+		d.Set("https_only", props.HTTPSOnly)
+		d.Set("enabled", props.Enabled)
+		d.Set("default_site_hostname", props.DefaultHostName)
+		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
+	}
+
+	if err := d.Set("site_config", flattenAppServiceSiteConfig(configResp.SiteConfig)); err != nil {
+		return err
+	}
+
+	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
+		return err
+	}
+
+	if err := d.Set("connection_string", flattenAppServiceConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return err
+	}
+
+	if err := d.Set("sticky_settings", flattenAppServiceStickySettings(stickySettingsResp.SlotConfigNames)); err != nil {
+		return err
+	}
+
+	if err := d.Set("auth_settings", flattenAppServiceAuthSettings(authSettingsResp.SiteAuthSettingsProperties)); err != nil {
+		return err
+	}
+
+	if err := d.Set("backup", flattenAppServiceBackup(backupResp.BackupRequestProperties)); err != nil {
+		return err
+	}
+
+	if err := d.Set("logs", flattenAppServiceLogs(logsResp.SiteLogsConfigProperties)); err != nil {
+		return err
+	}
+
+	if err := d.Set("source_control", flattenAppServiceSourceControl(sourceControlResp.SiteSourceControlProperties)); err != nil {
+		return err
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	log.Printf("[DEBUG] Deleting App Service %q (Resource Group %q)", name, resGroup)
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	skipDNSRegistration := true
+	resp, err := client.Delete(ctx, resGroup, name, &deleteMetrics, &deleteEmptyServerFarm, &skipDNSRegistration)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting App Service %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAppService(d *schema.ResourceData) web.Site {
+	location := d.Get("location").(string)
+	appServicePlanId := d.Get("app_service_plan_id").(string)
+	enabled := d.Get("enabled").(bool)
+	clientAffinityEnabled := d.Get("client_affinity_enabled").(bool)
+	httpsOnly := d.Get("https_only").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	siteConfig := expandAppServiceSiteConfig(d)
+
+	site := web.Site{
+		Location: &location,
+		Tags:     expandTags(tags),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID:          utils.String(appServicePlanId),
+			Enabled:               utils.Bool(enabled),
+			ClientAffinityEnabled: utils.Bool(clientAffinityEnabled),
+			HTTPSOnly:             utils.Bool(httpsOnly),
+			SiteConfig:            &siteConfig,
+		},
+	}
+
+	return site
+}
+
+func updateAppServiceAppSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	settings := web.StringDictionary{
+		Properties: expandAppServiceAppSettings(d),
+	}
+
+	if _, err := client.UpdateApplicationSettings(ctx, resGroup, name, settings); err != nil {
+		return fmt.Errorf("Error updating Application Settings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func updateAppServiceConnectionStrings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	connectionStrings := web.ConnectionStringDictionary{
+		Properties: expandAppServiceConnectionStrings(d),
+	}
+
+	if _, err := client.UpdateConnectionStrings(ctx, resGroup, name, connectionStrings); err != nil {
+		return fmt.Errorf("Error updating Connection Strings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func updateAppServiceStickySettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	stickySettings := web.SlotConfigNamesResource{
+		SlotConfigNames: expandAppServiceStickySettings(d),
+	}
+
+	if _, err := client.UpdateSlotConfigurationNames(ctx, resGroup, name, stickySettings); err != nil {
+		return fmt.Errorf("Error updating Sticky Settings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func updateAppServiceAuthSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	authSettings := expandAppServiceAuthSettings(d)
+
+	if _, err := client.UpdateAuthSettings(ctx, resGroup, name, authSettings); err != nil {
+		return fmt.Errorf("Error updating Authentication Settings for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func updateAppServiceBackup(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	backup, err := expandAppServiceBackup(d)
+	if err != nil {
+		return err
+	}
+
+	if backup == nil {
+		resp, err := client.DeleteBackupConfiguration(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error removing the Backup Configuration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		return nil
+	}
+
+	if _, err := client.UpdateBackupConfiguration(ctx, resGroup, name, *backup); err != nil {
+		return fmt.Errorf("Error updating the Backup Configuration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func updateAppServiceLogs(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	logs := expandAppServiceLogs(d)
+
+	if _, err := client.UpdateDiagnosticLogsConfig(ctx, resGroup, name, logs); err != nil {
+		return fmt.Errorf("Error updating the Diagnostic Logs Configuration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func updateAppServiceSourceControl(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	sourceControl := expandAppServiceSourceControl(d)
+
+	if sourceControl == nil {
+		resp, err := client.DeleteSourceControl(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error removing the Source Control for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		return nil
+	}
+
+	future, err := client.CreateOrUpdateSourceControl(ctx, resGroup, name, *sourceControl)
+	if err != nil {
+		return fmt.Errorf("Error updating the Source Control for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the update of the Source Control for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// There's no Importer for this resource, since a swap exchanges the content of a Slot with Production
+// rather than recording which Slot is active - as such `app_service_slot_name` can't be determined from Azure
+func resourceArmAppServiceActiveSlot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceActiveSlotCreateUpdate,
+		Read:   resourceArmAppServiceActiveSlotRead,
+		Update: resourceArmAppServiceActiveSlotCreateUpdate,
+		Delete: resourceArmAppServiceActiveSlotDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_slot_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceActiveSlotCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	targetSlot := d.Get("app_service_slot_name").(string)
+
+	appService, err := client.Get(ctx, resGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(appService.Response) {
+			return fmt.Errorf("Error: App Service %q (Resource Group %q) was not found", appServiceName, resGroup)
+		}
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %+v", appServiceName, resGroup, err)
+	}
+
+	if _, err := client.GetSlot(ctx, resGroup, appServiceName, targetSlot); err != nil {
+		return fmt.Errorf("Error retrieving Slot %q (App Service %q / Resource Group %q): %+v", targetSlot, appServiceName, resGroup, err)
+	}
+
+	log.Printf("[DEBUG] Swapping Slot %q of App Service %q (Resource Group %q) with Production", targetSlot, appServiceName, resGroup)
+
+	swap := web.CsmSlotEntity{
+		TargetSlot:   utils.String(targetSlot),
+		PreserveVnet: utils.Bool(true),
+	}
+	future, err := client.SwapSlotWithProduction(ctx, resGroup, appServiceName, swap)
+	if err != nil {
+		return fmt.Errorf("Error swapping Slot %q of App Service %q (Resource Group %q) with Production: %+v", targetSlot, appServiceName, resGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Slot %q of App Service %q (Resource Group %q) to swap with Production: %+v", targetSlot, appServiceName, resGroup, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(*appService.ID)
+	}
+
+	return resourceArmAppServiceActiveSlotRead(d, meta)
+}
+
+func resourceArmAppServiceActiveSlotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+
+	resp, err := client.Get(ctx, resGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service %q (Resource Group %q) was not found - removing from state", appServiceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service %q (Resource Group %q): %+v", appServiceName, resGroup, err)
+	}
+
+	// the swap exchanges the content of the Slot with Production, rather than changing which Slot is
+	// active - as such the only thing to check is that the Slot being tracked still exists
+	targetSlot := d.Get("app_service_slot_name").(string)
+	slotResp, err := client.GetSlot(ctx, resGroup, appServiceName, targetSlot)
+	if err != nil {
+		if utils.ResponseWasNotFound(slotResp.Response) {
+			log.Printf("[DEBUG] Slot %q (App Service %q / Resource Group %q) was not found - removing from state", targetSlot, appServiceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Slot %q (App Service %q / Resource Group %q): %+v", targetSlot, appServiceName, resGroup, err)
+	}
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("app_service_name", appServiceName)
+
+	return nil
+}
+
+func resourceArmAppServiceActiveSlotDelete(d *schema.ResourceData, meta interface{}) error {
+	// there's nothing to delete - the content which was swapped into Production remains in place
+	log.Printf("[DEBUG] Removing the Active Slot for App Service %q from state - Production is left as-is", d.Get("app_service_name").(string))
+	return nil
+}
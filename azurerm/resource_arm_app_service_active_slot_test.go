@@ -0,0 +1,124 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMAppServiceActiveSlot_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_active_slot.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMAppServiceActiveSlot_basic(ri, location, "first")
+	updatedConfig := testAccAzureRMAppServiceActiveSlot_basic(ri, location, "second")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceActiveSlotAppSetting("azurerm_app_service.test", "slot", "first"),
+					resource.TestCheckResourceAttr(resourceName, "app_service_slot_name", "first"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceActiveSlotAppSetting("azurerm_app_service.test", "slot", "second"),
+					resource.TestCheckResourceAttr(resourceName, "app_service_slot_name", "second"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckAzureRMAppServiceActiveSlotAppSetting checks the value of an App Setting in Production, which
+// (since the settings aren't sticky) identifies the Slot which was swapped into Production
+func testCheckAzureRMAppServiceActiveSlotAppSetting(name string, key string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		appServiceName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).appsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+		if err != nil {
+			return fmt.Errorf("Bad: ListApplicationSettings on appsClient: %+v", err)
+		}
+
+		settings := flattenAppServiceAppSettings(resp.Properties)
+		if actual := settings[key]; actual != expected {
+			return fmt.Errorf("Bad: expected the App Setting %q of App Service %q to be %q but got %q", key, appServiceName, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceActiveSlot_basic(rInt int, location string, activeSlot string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  lifecycle {
+    ignore_changes = ["app_settings"]
+  }
+}
+
+resource "azurerm_app_service_slot" "first" {
+  name                = "first"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+  app_service_name    = "${azurerm_app_service.test.name}"
+
+  app_settings {
+    "slot" = "first"
+  }
+
+  lifecycle {
+    ignore_changes = ["app_settings"]
+  }
+}
+
+resource "azurerm_app_service_slot" "second" {
+  name                = "second"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+  app_service_name    = "${azurerm_app_service.test.name}"
+
+  app_settings {
+    "slot" = "second"
+  }
+
+  lifecycle {
+    ignore_changes = ["app_settings"]
+  }
+}
+
+resource "azurerm_app_service_active_slot" "test" {
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  app_service_name      = "${azurerm_app_service.test.name}"
+  app_service_slot_name = "${azurerm_app_service_slot.%s.name}"
+}
+`, template, rInt, activeSlot)
+}
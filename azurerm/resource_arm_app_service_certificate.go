@@ -0,0 +1,292 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceCertificateCreateUpdate,
+		Read:   resourceArmAppServiceCertificateRead,
+		Update: resourceArmAppServiceCertificateCreateUpdate,
+		Delete: resourceArmAppServiceCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"pfx_blob": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ValidateFunc:  validateAppServiceCertificatePfxBlob,
+				ConflictsWith: []string{"key_vault_id", "key_vault_secret_id"},
+			},
+
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"key_vault_id", "key_vault_secret_id"},
+			},
+
+			"key_vault_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"pfx_blob", "password"},
+			},
+
+			"key_vault_secret_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"pfx_blob", "password"},
+			},
+
+			"tags": tagsSchema(),
+
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subject_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"issue_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificatesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM App Service Certificate creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	location := d.Get("location").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := web.CertificateProperties{}
+
+	pfxBlob := d.Get("pfx_blob").(string)
+	keyVaultId := d.Get("key_vault_id").(string)
+	keyVaultSecretId := d.Get("key_vault_secret_id").(string)
+
+	if pfxBlob != "" {
+		decoded, err := base64.StdEncoding.DecodeString(pfxBlob)
+		if err != nil {
+			return fmt.Errorf("Error decoding `pfx_blob` for App Service Certificate %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		properties.PfxBlob = &decoded
+		properties.Password = utils.String(d.Get("password").(string))
+	} else if keyVaultId != "" && keyVaultSecretId != "" {
+		secretName, err := validateAppServiceCertificateKeyVaultSecret(meta, keyVaultId, keyVaultSecretId)
+		if err != nil {
+			return err
+		}
+
+		properties.KeyVaultID = utils.String(keyVaultId)
+		properties.KeyVaultSecretName = utils.String(secretName)
+	} else {
+		return fmt.Errorf("Either `pfx_blob` or both `key_vault_id` and `key_vault_secret_id` must be specified")
+	}
+
+	certificate := web.Certificate{
+		Location:              &location,
+		Tags:                  expandTags(tags),
+		CertificateProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resGroup, name, certificate); err != nil {
+		return fmt.Errorf("Error creating/updating App Service Certificate %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service Certificate %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read App Service Certificate %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceCertificateRead(d, meta)
+}
+
+func resourceArmAppServiceCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificatesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["certificates"]
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service Certificate %q (Resource Group %q) was not found - removing from state", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service Certificate %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.CertificateProperties; props != nil {
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("subject_name", props.SubjectName)
+		d.Set("issuer", props.Issuer)
+		d.Set("thumbprint", props.Thumbprint)
+
+		hostNames := make([]string, 0)
+		if props.HostNames != nil {
+			hostNames = *props.HostNames
+		}
+		if err := d.Set("host_names", hostNames); err != nil {
+			return err
+		}
+
+		if props.IssueDate != nil {
+			d.Set("issue_date", props.IssueDate.Format(time.RFC3339))
+		}
+		if props.ExpirationDate != nil {
+			d.Set("expiration_date", props.ExpirationDate.Format(time.RFC3339))
+		}
+
+		// the Secret ID can't be determined from the API - only the name of the Secret is returned
+		if props.KeyVaultID != nil {
+			d.Set("key_vault_id", props.KeyVaultID)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServiceCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificatesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["certificates"]
+
+	log.Printf("[DEBUG] Deleting App Service Certificate %q (Resource Group %q)", name, resGroup)
+
+	resp, err := client.Delete(ctx, resGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting App Service Certificate %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAppServiceCertificateKeyVaultSecret confirms the Secret lives within the specified Key Vault,
+// returning the name of the Secret - since the App Service API references the Key Vault by its Resource ID
+func validateAppServiceCertificateKeyVaultSecret(meta interface{}, keyVaultId string, keyVaultSecretId string) (string, error) {
+	client := meta.(*ArmClient).keyVaultClient
+
+	secretId, err := parseKeyVaultSecretID(keyVaultSecretId)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := parseAzureResourceID(keyVaultId)
+	if err != nil {
+		return "", err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	vault, err := client.Get(resGroup, vaultName)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	if props := vault.Properties; props != nil && props.VaultURI != nil {
+		vaultUri := strings.TrimSuffix(*props.VaultURI, "/")
+		if !strings.EqualFold(vaultUri, strings.TrimSuffix(secretId.KeyVaultBaseUrl, "/")) {
+			return "", fmt.Errorf("Error: the Secret %q isn't within the Key Vault %q (%s)", keyVaultSecretId, vaultName, vaultUri)
+		}
+	}
+
+	return secretId.Name, nil
+}
+
+func validateAppServiceCertificatePfxBlob(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !isBase64Encoded(value) {
+		errors = append(errors, fmt.Errorf("%q must be a base64 encoded PFX file", k))
+	}
+
+	return
+}
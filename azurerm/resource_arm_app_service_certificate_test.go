@@ -0,0 +1,113 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceCertificate_pfx(t *testing.T) {
+	resourceName := "azurerm_app_service_certificate.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppServiceCertificate_pfx(t, ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subject_name", "acctest.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "thumbprint"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServiceCertificatesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_certificate" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("App Service Certificate still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceCertificateExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		certificateName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for App Service Certificate: %s", certificateName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appServiceCertificatesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, certificateName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: App Service Certificate %q (resource group: %q) does not exist", certificateName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServiceCertificatesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceCertificate_pfx(t *testing.T, rInt int, location string) string {
+	pfx, err := ioutil.ReadFile("testdata/app_service_certificate.pfx")
+	if err != nil {
+		t.Fatalf("Error reading the test PFX file: %+v", err)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_certificate" "test" {
+  name                = "acctestASC-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  pfx_blob            = "%s"
+  password            = "terraform"
+}
+`, rInt, location, rInt, base64.StdEncoding.EncodeToString(pfx))
+}
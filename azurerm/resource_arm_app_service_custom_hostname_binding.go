@@ -0,0 +1,248 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceCustomHostnameBindingCreateUpdate,
+		Read:   resourceArmAppServiceCustomHostnameBindingRead,
+		Update: resourceArmAppServiceCustomHostnameBindingCreateUpdate,
+		Delete: resourceArmAppServiceCustomHostnameBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ssl_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(web.Disabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.Disabled),
+					string(web.IPBasedEnabled),
+					string(web.SniEnabled),
+				}, false),
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"virtual_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceCustomHostnameBindingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM App Service Hostname Binding creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	hostname := d.Get("hostname").(string)
+	sslState := d.Get("ssl_state").(string)
+	thumbprint := d.Get("thumbprint").(string)
+
+	if sslState != string(web.Disabled) && thumbprint == "" {
+		return fmt.Errorf("`thumbprint` must be specified when `ssl_state` is set to %q", sslState)
+	}
+
+	appService, err := client.Get(ctx, resGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(appService.Response) {
+			return fmt.Errorf("Error: App Service %q (Resource Group %q) was not found", appServiceName, resGroup)
+		}
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %+v", appServiceName, resGroup, err)
+	}
+
+	// bindings to the same App Service conflict with one another, so these are applied one at a time
+	azureRMLockByID(*appService.ID)
+	defer azureRMUnlockByID(*appService.ID)
+
+	if d.IsNewResource() {
+		analysis, err := client.AnalyzeCustomHostname(ctx, resGroup, appServiceName, hostname)
+		if err != nil {
+			return fmt.Errorf("Error analyzing Hostname %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+		}
+
+		if err := validateAppServiceCustomHostnameAnalysis(hostname, analysis); err != nil {
+			return err
+		}
+	}
+
+	properties := web.HostNameBindingProperties{
+		SiteName: utils.String(appServiceName),
+		SslState: web.SslState(sslState),
+	}
+
+	if thumbprint != "" {
+		properties.Thumbprint = utils.String(thumbprint)
+	}
+
+	binding := web.HostNameBinding{
+		HostNameBindingProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdateHostNameBinding(ctx, resGroup, appServiceName, hostname, binding); err != nil {
+		return fmt.Errorf("Error binding Hostname %q to App Service %q (Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+	}
+
+	read, err := client.GetHostNameBinding(ctx, resGroup, appServiceName, hostname)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Hostname Binding %q (App Service %q / Resource Group %q) ID", hostname, appServiceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceCustomHostnameBindingRead(d, meta)
+}
+
+func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	hostname := id.Path["hostNameBindings"]
+
+	resp, err := client.GetHostNameBinding(ctx, resGroup, appServiceName, hostname)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Hostname Binding %q (App Service %q / Resource Group %q) was not found - removing from state", hostname, appServiceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+	}
+
+	d.Set("hostname", hostname)
+	d.Set("resource_group_name", resGroup)
+	d.Set("app_service_name", appServiceName)
+
+	if props := resp.HostNameBindingProperties; props != nil {
+		sslState := string(props.SslState)
+		if sslState == "" {
+			sslState = string(web.Disabled)
+		}
+		d.Set("ssl_state", sslState)
+		d.Set("thumbprint", props.Thumbprint)
+		d.Set("virtual_ip", props.VirtualIP)
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	hostname := id.Path["hostNameBindings"]
+
+	appServiceId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s", id.SubscriptionID, resGroup, appServiceName)
+	azureRMLockByID(appServiceId)
+	defer azureRMUnlockByID(appServiceId)
+
+	log.Printf("[DEBUG] Deleting Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resGroup)
+
+	resp, err := client.DeleteHostNameBinding(ctx, resGroup, appServiceName, hostname)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAppServiceCustomHostnameAnalysis surfaces the reason a Hostname can't be bound, since the error
+// returned from the Binding API itself doesn't detail which DNS records are missing or which App conflicts
+func validateAppServiceCustomHostnameAnalysis(hostname string, analysis web.CustomHostnameAnalysisResult) error {
+	props := analysis.CustomHostnameAnalysisResultProperties
+	if props == nil {
+		return nil
+	}
+
+	if props.HasConflictAcrossSubscription != nil && *props.HasConflictAcrossSubscription {
+		return fmt.Errorf("Error: Hostname %q is already bound to an App Service in another Subscription", hostname)
+	}
+
+	if props.HasConflictOnScaleUnit != nil && *props.HasConflictOnScaleUnit {
+		conflictingApp := ""
+		if props.ConflictingAppResourceID != nil {
+			conflictingApp = *props.ConflictingAppResourceID
+		}
+		return fmt.Errorf("Error: Hostname %q is already bound to the App Service %q", hostname, conflictingApp)
+	}
+
+	if props.IsHostnameAlreadyVerified != nil && *props.IsHostnameAlreadyVerified {
+		return nil
+	}
+
+	if props.CustomDomainVerificationTest == web.DNSVerificationTestResultFailed {
+		message := "the DNS Records for this Hostname couldn't be verified"
+		if info := props.CustomDomainVerificationFailureInfo; info != nil && info.Message != nil {
+			message = *info.Message
+		}
+
+		records := make([]string, 0)
+		if props.CNameRecords != nil {
+			records = append(records, *props.CNameRecords...)
+		}
+		if props.TxtRecords != nil {
+			records = append(records, *props.TxtRecords...)
+		}
+		if props.ARecords != nil {
+			records = append(records, *props.ARecords...)
+		}
+
+		return fmt.Errorf("Error verifying Hostname %q: %s (found DNS Records: [%s])", hostname, message, strings.Join(records, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Binding a Custom Hostname requires a DNS Zone delegated to Azure DNS, which is specified via the
+// `ARM_TEST_DNS_ZONE` and `ARM_TEST_DATA_RESOURCE_GROUP` environment variables
+func TestAccAzureRMAppServiceCustomHostnameBinding_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_custom_hostname_binding.test"
+	ri := acctest.RandInt()
+
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+	if dnsZone == "" || dataResourceGroup == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+		return
+	}
+
+	config := testAccAzureRMAppServiceCustomHostnameBinding_basic(ri, testLocation(), dnsZone, dataResourceGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCustomHostnameBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCustomHostnameBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssl_state", "Disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateAppServiceCustomHostnameAnalysis(t *testing.T) {
+	cases := []struct {
+		Properties  *web.CustomHostnameAnalysisResultProperties
+		ExpectError bool
+	}{
+		{
+			Properties:  nil,
+			ExpectError: false,
+		},
+		{
+			Properties: &web.CustomHostnameAnalysisResultProperties{
+				IsHostnameAlreadyVerified: utils.Bool(true),
+			},
+			ExpectError: false,
+		},
+		{
+			Properties: &web.CustomHostnameAnalysisResultProperties{
+				CustomDomainVerificationTest: web.DNSVerificationTestResultPassed,
+			},
+			ExpectError: false,
+		},
+		{
+			Properties: &web.CustomHostnameAnalysisResultProperties{
+				CustomDomainVerificationTest: web.DNSVerificationTestResultFailed,
+				CNameRecords:                 &[]string{"some-other-app.azurewebsites.net"},
+			},
+			ExpectError: true,
+		},
+		{
+			Properties: &web.CustomHostnameAnalysisResultProperties{
+				IsHostnameAlreadyVerified: utils.Bool(true),
+				HasConflictOnScaleUnit:    utils.Bool(true),
+				ConflictingAppResourceID:  utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/app1"),
+			},
+			ExpectError: true,
+		},
+		{
+			Properties: &web.CustomHostnameAnalysisResultProperties{
+				IsHostnameAlreadyVerified:     utils.Bool(true),
+				HasConflictAcrossSubscription: utils.Bool(true),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for i, tc := range cases {
+		analysis := web.CustomHostnameAnalysisResult{
+			CustomHostnameAnalysisResultProperties: tc.Properties,
+		}
+
+		err := validateAppServiceCustomHostnameAnalysis("www.example.com", analysis)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for case %d but didn't get one", i)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for case %d but got: %+v", i, err)
+		}
+	}
+}
+
+func testCheckAzureRMAppServiceCustomHostnameBindingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_custom_hostname_binding" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		hostname := rs.Primary.Attributes["hostname"]
+
+		resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("App Service Custom Hostname Binding still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceCustomHostnameBindingExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		hostname := rs.Primary.Attributes["hostname"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for App Service Custom Hostname Binding: %s", hostname)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Hostname Binding %q (App Service %q / resource group: %q) does not exist", hostname, appServiceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: GetHostNameBinding on appsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceCustomHostnameBinding_basic(rInt int, location string, dnsZone string, dataResourceGroup string) string {
+	template := testAccAzureRMAppService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "acctest%d"
+  zone_name           = "%s"
+  resource_group_name = "%s"
+  ttl                 = 300
+  record              = "${azurerm_app_service.test.default_site_hostname}"
+}
+
+resource "azurerm_app_service_custom_hostname_binding" "test" {
+  hostname            = "acctest%d.%s"
+  app_service_name    = "${azurerm_app_service.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  depends_on = ["azurerm_dns_cname_record.test"]
+}
+`, template, rInt, dnsZone, dataResourceGroup, rInt, dnsZone)
+}
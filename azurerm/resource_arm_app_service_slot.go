@@ -0,0 +1,306 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceSlot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceSlotCreate,
+		Read:   resourceArmAppServiceSlotRead,
+		Update: resourceArmAppServiceSlotUpdate,
+		Delete: resourceArmAppServiceSlotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"site_config": appServiceSiteConfigSchema(),
+
+			"app_settings": appServiceAppSettingsSchema(),
+
+			"connection_string": appServiceConnectionStringSchema(),
+
+			"client_affinity_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+
+			"default_site_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM App Service Slot creation.")
+
+	slot := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+
+	site := expandAppService(d)
+
+	future, err := client.CreateOrUpdateSlot(ctx, resGroup, appServiceName, site, slot, nil, nil, nil, "")
+	if err != nil {
+		return fmt.Errorf("Error creating Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the creation of Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	read, err := client.GetSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Slot %q (App Service %q / Resource Group %q) ID", slot, appServiceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	if err := updateAppServiceSlotAppSettings(d, meta); err != nil {
+		return err
+	}
+
+	if err := updateAppServiceSlotConnectionStrings(d, meta); err != nil {
+		return err
+	}
+
+	return resourceArmAppServiceSlotRead(d, meta)
+}
+
+func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	if d.HasChange("site_config") || d.HasChange("client_affinity_enabled") || d.HasChange("https_only") ||
+		d.HasChange("enabled") || d.HasChange("tags") {
+		site := expandAppService(d)
+
+		future, err := client.CreateOrUpdateSlot(ctx, resGroup, appServiceName, site, slot, nil, nil, nil, "")
+		if err != nil {
+			return fmt.Errorf("Error updating Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+		}
+
+		err = future.WaitForCompletion(ctx, client.Client)
+		if err != nil {
+			return fmt.Errorf("Error waiting for the update of Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+		}
+	}
+
+	if d.HasChange("app_settings") {
+		if err := updateAppServiceSlotAppSettings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("connection_string") {
+		if err := updateAppServiceSlotConnectionStrings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmAppServiceSlotRead(d, meta)
+}
+
+func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	resp, err := client.GetSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Slot %q (App Service %q / Resource Group %q) was not found - removing from state", slot, appServiceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	configResp, err := client.GetConfigurationSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Configuration for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Application Settings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	connectionStringsResp, err := client.ListConnectionStringsSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Connection Strings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	d.Set("name", slot)
+	d.Set("resource_group_name", resGroup)
+	d.Set("app_service_name", appServiceName)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.SiteProperties; props != nil {
+		d.Set("https_only", props.HTTPSOnly)
+		d.Set("app_service_plan_id", props.ServerFarmID)
+		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
+		d.Set("enabled", props.Enabled)
+		d.Set("default_site_hostname", props.DefaultHostName)
+	}
+
+	if err := d.Set("site_config", flattenAppServiceSiteConfig(configResp.SiteConfig)); err != nil {
+		return err
+	}
+
+	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
+		return err
+	}
+
+	if err := d.Set("connection_string", flattenAppServiceConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return err
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServiceSlotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	log.Printf("[DEBUG] Deleting Slot %q (App Service %q / Resource Group %q)", slot, appServiceName, resGroup)
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	skipDNSRegistration := true
+	resp, err := client.DeleteSlot(ctx, resGroup, appServiceName, slot, &deleteMetrics, &deleteEmptyServerFarm, &skipDNSRegistration)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func updateAppServiceSlotAppSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	settings := web.StringDictionary{
+		Properties: expandAppServiceAppSettings(d),
+	}
+
+	if _, err := client.UpdateApplicationSettingsSlot(ctx, resGroup, appServiceName, settings, slot); err != nil {
+		return fmt.Errorf("Error updating Application Settings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	return nil
+}
+
+func updateAppServiceSlotConnectionStrings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	connectionStrings := web.ConnectionStringDictionary{
+		Properties: expandAppServiceConnectionStrings(d),
+	}
+
+	if _, err := client.UpdateConnectionStringsSlot(ctx, resGroup, appServiceName, connectionStrings, slot); err != nil {
+		return fmt.Errorf("Error updating Connection Strings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,549 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppService_basic(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppService_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "default_site_hostname"),
+					resource.TestCheckResourceAttrSet(resourceName, "outbound_ip_addresses"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_siteConfig(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppService_siteConfig(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.always_on", "true"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.default_documents.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.php_version", "7.1"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.use_32_bit_worker_process", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_appSettings(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMAppService_appSettings(ri, location, "bar")
+	updatedConfig := testAccAzureRMAppService_appSettings(ri, location, "baz")
+	removedConfig := testAccAzureRMAppService_basic(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.foo", "bar"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.foo", "baz"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_connectionStrings(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppService_connectionStrings(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_string.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_httpsOnlyAndClientAffinity(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMAppService_basic(ri, location)
+	updatedConfig := testAccAzureRMAppService_httpsOnlyAndClientAffinity(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "https_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "client_affinity_enabled", "true"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "https_only", "true"),
+					resource.TestCheckResourceAttr(resourceName, "client_affinity_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_stickySettings(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppService_stickySettings(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sticky_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sticky_settings.0.app_setting_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sticky_settings.0.app_setting_names.0", "environment"),
+					resource.TestCheckResourceAttr(resourceName, "sticky_settings.0.connection_string_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_authSettings(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	enabledConfig := testAccAzureRMAppService_authSettings(ri, location)
+	disabledConfig := testAccAzureRMAppService_basic(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: enabledConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auth_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auth_settings.0.default_provider", "AzureActiveDirectory"),
+					resource.TestCheckResourceAttr(resourceName, "auth_settings.0.active_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_settings.0.active_directory.0.client_id", "aadclientid"),
+					resource.TestCheckResourceAttr(resourceName, "auth_settings.0.additional_login_params.%", "1"),
+				),
+			},
+			{
+				Config: disabledConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auth_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_logs(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppService_logs(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.detailed_error_messages_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.failed_request_tracing_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.application_logs.0.file_system_level", "Warning"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.http_logs.0.file_system.0.retention_in_mb", "35"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.http_logs.0.file_system.0.retention_in_days", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_sourceControl(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMAppService_sourceControl(ri, location)
+	removedConfig := testAccAzureRMAppService_basic(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_control.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_control.0.repo_url", "https://github.com/Azure-Samples/app-service-web-html-get-started"),
+					resource.TestCheckResourceAttr(resourceName, "source_control.0.branch", "master"),
+					resource.TestCheckResourceAttr(resourceName, "source_control.0.manual_integration", "true"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_control.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("App Service still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		appServiceName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for App Service: %s", appServiceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, appServiceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: App Service %q (resource group: %q) does not exist", appServiceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppService_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMAppService_basic(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppService_siteConfig(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    always_on                 = true
+    default_documents         = ["first.html", "second.html"]
+    php_version               = "7.1"
+    use_32_bit_worker_process = true
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppService_appSettings(rInt int, location string, value string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  app_settings {
+    "foo" = "%s"
+  }
+}
+`, template, rInt, value)
+}
+
+func testAccAzureRMAppService_connectionStrings(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  connection_string {
+    name  = "First"
+    value = "some-postgresql-connection-string"
+    type  = "PostgreSQL"
+  }
+
+  connection_string {
+    name  = "Second"
+    value = "some-sql-server-connection-string"
+    type  = "SQLServer"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppService_httpsOnlyAndClientAffinity(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                    = "acctestAS-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  app_service_plan_id     = "${azurerm_app_service_plan.test.id}"
+  https_only              = true
+  client_affinity_enabled = false
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppService_stickySettings(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  app_settings {
+    "environment" = "production"
+  }
+
+  connection_string {
+    name  = "Database"
+    value = "some-sql-server-connection-string"
+    type  = "SQLServer"
+  }
+
+  sticky_settings {
+    app_setting_names       = ["environment"]
+    connection_string_names = ["Database"]
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppService_authSettings(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  auth_settings {
+    enabled                       = true
+    issuer                        = "https://sts.windows.net/%s"
+    default_provider              = "AzureActiveDirectory"
+    unauthenticated_client_action = "RedirectToLoginPage"
+
+    additional_login_params {
+      "test_key" = "test_value"
+    }
+
+    active_directory {
+      client_id         = "aadclientid"
+      client_secret     = "aadsecret"
+      allowed_audiences = ["activedirectorytokenaudiences"]
+    }
+  }
+}
+`, template, rInt, os.Getenv("ARM_TENANT_ID"))
+}
+
+func testAccAzureRMAppService_logs(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  logs {
+    detailed_error_messages_enabled = true
+    failed_request_tracing_enabled  = true
+
+    application_logs {
+      file_system_level = "Warning"
+    }
+
+    http_logs {
+      file_system {
+        retention_in_mb   = 35
+        retention_in_days = 7
+      }
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppService_sourceControl(rInt int, location string) string {
+	template := testAccAzureRMAppService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  source_control {
+    repo_url           = "https://github.com/Azure-Samples/app-service-web-html-get-started"
+    branch             = "master"
+    manual_integration = true
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceVirtualNetworkIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceVirtualNetworkIntegrationCreate,
+		Read:   resourceArmAppServiceVirtualNetworkIntegrationRead,
+		Delete: resourceArmAppServiceVirtualNetworkIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"virtual_network_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"cert_thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dns_servers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resync_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceVirtualNetworkIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM App Service Virtual Network Integration creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	virtualNetworkId := d.Get("virtual_network_id").(string)
+
+	vnetId, err := parseAzureResourceID(virtualNetworkId)
+	if err != nil {
+		return err
+	}
+	vnetName := vnetId.Path["virtualNetworks"]
+
+	appService, err := client.Get(ctx, resGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(appService.Response) {
+			return fmt.Errorf("Error: App Service %q (Resource Group %q) was not found", appServiceName, resGroup)
+		}
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %+v", appServiceName, resGroup, err)
+	}
+
+	azureRMLockByID(*appService.ID)
+	defer azureRMUnlockByID(*appService.ID)
+
+	connection := web.VnetInfo{
+		VnetInfoProperties: &web.VnetInfoProperties{
+			VnetResourceID: utils.String(virtualNetworkId),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateVnetConnection(ctx, resGroup, appServiceName, vnetName, connection); err != nil {
+		return fmt.Errorf("Error integrating Virtual Network %q with App Service %q (Resource Group %q): %+v", vnetName, appServiceName, resGroup, err)
+	}
+
+	// the Virtual Network Connection doesn't return an ID, so we build one from the ID of the App Service
+	d.SetId(fmt.Sprintf("%s/virtualNetworkConnections/%s", *appService.ID, vnetName))
+
+	return resourceArmAppServiceVirtualNetworkIntegrationRead(d, meta)
+}
+
+func resourceArmAppServiceVirtualNetworkIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	vnetName := id.Path["virtualNetworkConnections"]
+
+	resp, err := client.GetVnetConnection(ctx, resGroup, appServiceName, vnetName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Network Integration %q (App Service %q / Resource Group %q) was not found - removing from state", vnetName, appServiceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Virtual Network Integration %q (App Service %q / Resource Group %q): %+v", vnetName, appServiceName, resGroup, err)
+	}
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("app_service_name", appServiceName)
+
+	if props := resp.VnetInfoProperties; props != nil {
+		d.Set("virtual_network_id", props.VnetResourceID)
+		d.Set("cert_thumbprint", props.CertThumbprint)
+		d.Set("dns_servers", props.DNSServers)
+		d.Set("resync_required", props.ResyncRequired)
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceVirtualNetworkIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	vnetName := id.Path["virtualNetworkConnections"]
+
+	appServiceId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s", id.SubscriptionID, resGroup, appServiceName)
+	azureRMLockByID(appServiceId)
+	defer azureRMUnlockByID(appServiceId)
+
+	log.Printf("[DEBUG] Deleting Virtual Network Integration %q (App Service %q / Resource Group %q)", vnetName, appServiceName, resGroup)
+
+	resp, err := client.DeleteVnetConnection(ctx, resGroup, appServiceName, vnetName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Virtual Network Integration %q (App Service %q / Resource Group %q): %+v", vnetName, appServiceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
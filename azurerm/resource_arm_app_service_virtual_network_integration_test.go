@@ -0,0 +1,119 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceVirtualNetworkIntegration_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_virtual_network_integration.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppServiceVirtualNetworkIntegration_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceVirtualNetworkIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceVirtualNetworkIntegrationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "cert_thumbprint"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceVirtualNetworkIntegrationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_virtual_network_integration" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appServiceName := id.Path["sites"]
+		vnetName := id.Path["virtualNetworkConnections"]
+
+		resp, err := client.GetVnetConnection(ctx, resourceGroup, appServiceName, vnetName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("App Service Virtual Network Integration still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceVirtualNetworkIntegrationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appServiceName := id.Path["sites"]
+		vnetName := id.Path["virtualNetworkConnections"]
+
+		client := testAccProvider.Meta().(*ArmClient).appsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.GetVnetConnection(ctx, resourceGroup, appServiceName, vnetName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network Integration %q (App Service %q / resource group: %q) does not exist", vnetName, appServiceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: GetVnetConnection on appsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceVirtualNetworkIntegration_basic(rInt int, location string) string {
+	template := testAccAzureRMAppService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    name           = "GatewaySubnet"
+    address_prefix = "10.0.1.0/24"
+  }
+}
+
+resource "azurerm_app_service_virtual_network_integration" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_name    = "${azurerm_app_service.test.name}"
+  virtual_network_id  = "${azurerm_virtual_network.test.id}"
+}
+`, template, rInt)
+}
@@ -0,0 +1,204 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/graphrbac"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAzureADApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAzureADApplicationCreate,
+		Read:   resourceArmAzureADApplicationRead,
+		Update: resourceArmAzureADApplicationUpdate,
+		Delete: resourceArmAzureADApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"homepage": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"identifier_uris": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"reply_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"available_to_other_tenants": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAzureADApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationsClient
+	log.Printf("[INFO] preparing arguments for Azure Active Directory Application creation.")
+
+	name := d.Get("name").(string)
+	availableToOtherTenants := d.Get("available_to_other_tenants").(bool)
+
+	properties := graphrbac.ApplicationCreateParameters{
+		DisplayName:             utils.String(name),
+		Homepage:                expandAzureADApplicationHomepage(d, name),
+		IdentifierUris:          expandAzureADApplicationStringList(d, "identifier_uris"),
+		ReplyUrls:               expandAzureADApplicationStringList(d, "reply_urls"),
+		AvailableToOtherTenants: utils.Bool(availableToOtherTenants),
+	}
+
+	app, err := client.Create(properties)
+	if err != nil {
+		return fmt.Errorf("Error creating Azure Active Directory Application %q: %+v", name, err)
+	}
+	if app.ObjectID == nil {
+		return fmt.Errorf("Cannot read Azure Active Directory Application %q ID", name)
+	}
+
+	d.SetId(*app.ObjectID)
+
+	return resourceArmAzureADApplicationRead(d, meta)
+}
+
+func resourceArmAzureADApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationsClient
+	log.Printf("[INFO] preparing arguments for Azure Active Directory Application update.")
+
+	name := d.Get("name").(string)
+
+	var properties graphrbac.ApplicationUpdateParameters
+
+	if d.HasChange("name") {
+		properties.DisplayName = utils.String(name)
+	}
+
+	if d.HasChange("homepage") {
+		properties.Homepage = expandAzureADApplicationHomepage(d, name)
+	}
+
+	if d.HasChange("identifier_uris") {
+		properties.IdentifierUris = expandAzureADApplicationStringList(d, "identifier_uris")
+	}
+
+	if d.HasChange("reply_urls") {
+		properties.ReplyUrls = expandAzureADApplicationStringList(d, "reply_urls")
+	}
+
+	if d.HasChange("available_to_other_tenants") {
+		availableToOtherTenants := d.Get("available_to_other_tenants").(bool)
+		properties.AvailableToOtherTenants = utils.Bool(availableToOtherTenants)
+	}
+
+	if _, err := client.Patch(d.Id(), properties); err != nil {
+		return fmt.Errorf("Error patching Azure Active Directory Application %q: %+v", name, err)
+	}
+
+	return resourceArmAzureADApplicationRead(d, meta)
+}
+
+func resourceArmAzureADApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationsClient
+
+	resp, err := client.Get(d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Azure Active Directory Application with Object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Active Directory Application with Object ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("name", resp.DisplayName)
+	d.Set("application_id", resp.AppID)
+	d.Set("homepage", resp.Homepage)
+	d.Set("available_to_other_tenants", resp.AvailableToOtherTenants)
+
+	identifierUris := make([]string, 0)
+	if resp.IdentifierUris != nil {
+		identifierUris = *resp.IdentifierUris
+	}
+	if err := d.Set("identifier_uris", identifierUris); err != nil {
+		return fmt.Errorf("Error flattening `identifier_uris`: %+v", err)
+	}
+
+	replyUrls := make([]string, 0)
+	if resp.ReplyUrls != nil {
+		replyUrls = *resp.ReplyUrls
+	}
+	if err := d.Set("reply_urls", replyUrls); err != nil {
+		return fmt.Errorf("Error flattening `reply_urls`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmAzureADApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationsClient
+
+	// in order to delete an application which is available to other tenants, we first have to disable this setting
+	if d.Get("available_to_other_tenants").(bool) {
+		log.Printf("[DEBUG] Azure Active Directory Application is available to other tenants - disabling that feature before deleting.")
+		properties := graphrbac.ApplicationUpdateParameters{
+			AvailableToOtherTenants: utils.Bool(false),
+		}
+
+		if _, err := client.Patch(d.Id(), properties); err != nil {
+			return fmt.Errorf("Error patching Azure Active Directory Application with Object ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	resp, err := client.Delete(d.Id())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Azure Active Directory Application with Object ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureADApplicationHomepage(d *schema.ResourceData, name string) *string {
+	if v, ok := d.GetOk("homepage"); ok {
+		return utils.String(v.(string))
+	}
+
+	return utils.String(fmt.Sprintf("https://%s", name))
+}
+
+func expandAzureADApplicationStringList(d *schema.ResourceData, key string) *[]string {
+	input := d.Get(key).([]interface{})
+	result := make([]string, 0, len(input))
+
+	for _, v := range input {
+		result = append(result, v.(string))
+	}
+
+	return &result
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAzureADApplication_basic(t *testing.T) {
+	resourceName := "azurerm_azuread_application.test"
+	id := acctest.RandString(8)
+	config := testAccAzureRMAzureADApplication_basic(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctest%s", id)),
+					resource.TestCheckResourceAttr(resourceName, "homepage", fmt.Sprintf("https://acctest%s", id)),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAzureADApplication_availableToOtherTenants(t *testing.T) {
+	resourceName := "azurerm_azuread_application.test"
+	id := acctest.RandString(8)
+	config := testAccAzureRMAzureADApplication_availableToOtherTenants(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "available_to_other_tenants", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAzureADApplication_complete(t *testing.T) {
+	resourceName := "azurerm_azuread_application.test"
+	id := acctest.RandString(8)
+	config := testAccAzureRMAzureADApplication_complete(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctest%s", id)),
+					resource.TestCheckResourceAttr(resourceName, "homepage", fmt.Sprintf("https://homepage-%s", id)),
+					resource.TestCheckResourceAttr(resourceName, "identifier_uris.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reply_urls.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAzureADApplication_update(t *testing.T) {
+	resourceName := "azurerm_azuread_application.test"
+	id := acctest.RandString(8)
+	updatedId := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAzureADApplication_basic(id),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctest%s", id)),
+					resource.TestCheckResourceAttr(resourceName, "homepage", fmt.Sprintf("https://acctest%s", id)),
+					resource.TestCheckResourceAttr(resourceName, "identifier_uris.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "reply_urls.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMAzureADApplication_complete(updatedId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctest%s", updatedId)),
+					resource.TestCheckResourceAttr(resourceName, "homepage", fmt.Sprintf("https://homepage-%s", updatedId)),
+					resource.TestCheckResourceAttr(resourceName, "identifier_uris.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reply_urls.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAzureADApplicationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).applicationsClient
+		resp, err := client.Get(rs.Primary.ID)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Azure AD Application %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("Bad: Get on Azure AD applicationsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAzureADApplicationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_azuread_application" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).applicationsClient
+		resp, err := client.Get(rs.Primary.ID)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Azure AD Application still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAzureADApplication_basic(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name = "acctest%s"
+}
+`, id)
+}
+
+func testAccAzureRMAzureADApplication_availableToOtherTenants(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name                       = "acctest%s"
+  identifier_uris            = ["https://%s.hashicorptest.com"]
+  available_to_other_tenants = true
+}
+`, id, id)
+}
+
+func testAccAzureRMAzureADApplication_complete(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name                       = "acctest%s"
+  homepage                   = "https://homepage-%s"
+  identifier_uris            = ["http://%s.hashicorptest.com"]
+  reply_urls                 = ["http://%s.hashicorptest.com"]
+  available_to_other_tenants = false
+}
+`, id, id, id, id)
+}
@@ -0,0 +1,92 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/graphrbac"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAzureADServicePrincipal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAzureADServicePrincipalCreate,
+		Read:   resourceArmAzureADServicePrincipalRead,
+		Delete: resourceArmAzureADServicePrincipalDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAzureADServicePrincipalCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	log.Printf("[INFO] preparing arguments for Azure Active Directory Service Principal creation.")
+
+	applicationId := d.Get("application_id").(string)
+
+	properties := graphrbac.ServicePrincipalCreateParameters{
+		AppID: utils.String(applicationId),
+		// this can't be retrieved or changed via the API, so we default it to true
+		AccountEnabled: utils.Bool(true),
+	}
+
+	sp, err := client.Create(properties)
+	if err != nil {
+		return fmt.Errorf("Error creating Azure Active Directory Service Principal for Application %q: %+v", applicationId, err)
+	}
+	if sp.ObjectID == nil {
+		return fmt.Errorf("Cannot read Azure Active Directory Service Principal for Application %q ID", applicationId)
+	}
+
+	d.SetId(*sp.ObjectID)
+
+	return resourceArmAzureADServicePrincipalRead(d, meta)
+}
+
+func resourceArmAzureADServicePrincipalRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+
+	resp, err := client.Get(d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Azure Active Directory Service Principal with Object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Active Directory Service Principal with Object ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("application_id", resp.AppID)
+	d.Set("display_name", resp.DisplayName)
+
+	return nil
+}
+
+func resourceArmAzureADServicePrincipalDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+
+	resp, err := client.Delete(d.Id())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Azure Active Directory Service Principal with Object ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/graphrbac"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/satori/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAzureADServicePrincipalPassword() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAzureADServicePrincipalPasswordCreate,
+		Read:   resourceArmAzureADServicePrincipalPasswordRead,
+		Delete: resourceArmAzureADServicePrincipalPasswordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"start_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: rfc3339TimeDiffSuppressFunc,
+			},
+
+			"end_date": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: rfc3339TimeDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceArmAzureADServicePrincipalPasswordCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	log.Printf("[INFO] preparing arguments for Azure Active Directory Service Principal Password creation.")
+
+	objectId := d.Get("service_principal_id").(string)
+
+	credential, err := expandAzureADServicePrincipalPasswordCredential(d)
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByID(objectId)
+	defer azureRMUnlockByID(objectId)
+
+	existing, err := client.ListPasswordCredentials(objectId)
+	if err != nil {
+		return fmt.Errorf("Error listing Passwords for Azure Active Directory Service Principal %q: %+v", objectId, err)
+	}
+
+	// the existing credentials don't include their values, which the API interprets as leaving them unchanged
+	credentials := make([]graphrbac.PasswordCredential, 0)
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.KeyID != nil && strings.EqualFold(*v.KeyID, *credential.KeyID) {
+				return fmt.Errorf("A Password with Key ID %q already exists for Azure Active Directory Service Principal %q - to be managed via Terraform this resource needs to be imported into the State", *credential.KeyID, objectId)
+			}
+			credentials = append(credentials, v)
+		}
+	}
+	credentials = append(credentials, *credential)
+
+	parameters := graphrbac.PasswordCredentialsUpdateParameters{
+		Value: &credentials,
+	}
+	if _, err := client.UpdatePasswordCredentials(objectId, parameters); err != nil {
+		return fmt.Errorf("Error creating Password for Azure Active Directory Service Principal %q: %+v", objectId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectId, *credential.KeyID))
+
+	return resourceArmAzureADServicePrincipalPasswordRead(d, meta)
+}
+
+func resourceArmAzureADServicePrincipalPasswordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+
+	objectId, keyId, err := parseAzureADServicePrincipalPasswordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Service Principal may have been removed, in which case so has the Password
+	sp, err := client.Get(objectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(sp.Response) {
+			log.Printf("[DEBUG] Azure Active Directory Service Principal %q was not found - removing Password from state", objectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Azure Active Directory Service Principal %q: %+v", objectId, err)
+	}
+
+	credentials, err := client.ListPasswordCredentials(objectId)
+	if err != nil {
+		return fmt.Errorf("Error listing Passwords for Azure Active Directory Service Principal %q: %+v", objectId, err)
+	}
+
+	var credential *graphrbac.PasswordCredential
+	if credentials.Value != nil {
+		for _, v := range *credentials.Value {
+			if v.KeyID != nil && strings.EqualFold(*v.KeyID, keyId) {
+				c := v
+				credential = &c
+				break
+			}
+		}
+	}
+
+	if credential == nil {
+		log.Printf("[DEBUG] Password %q was not found for Azure Active Directory Service Principal %q - removing from state", keyId, objectId)
+		d.SetId("")
+		return nil
+	}
+
+	// the value isn't returned from the API, so we use the value from the config
+	d.Set("service_principal_id", objectId)
+	d.Set("key_id", keyId)
+
+	if startDate := credential.StartDate; startDate != nil {
+		d.Set("start_date", startDate.Format(time.RFC3339))
+	}
+
+	if endDate := credential.EndDate; endDate != nil {
+		d.Set("end_date", endDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceArmAzureADServicePrincipalPasswordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+
+	objectId, keyId, err := parseAzureADServicePrincipalPasswordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByID(objectId)
+	defer azureRMUnlockByID(objectId)
+
+	existing, err := client.ListPasswordCredentials(objectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error listing Passwords for Azure Active Directory Service Principal %q: %+v", objectId, err)
+	}
+
+	found := false
+	credentials := make([]graphrbac.PasswordCredential, 0)
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.KeyID != nil && strings.EqualFold(*v.KeyID, keyId) {
+				found = true
+				continue
+			}
+			credentials = append(credentials, v)
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	parameters := graphrbac.PasswordCredentialsUpdateParameters{
+		Value: &credentials,
+	}
+	if _, err := client.UpdatePasswordCredentials(objectId, parameters); err != nil {
+		return fmt.Errorf("Error removing Password %q from Azure Active Directory Service Principal %q: %+v", keyId, objectId, err)
+	}
+
+	return nil
+}
+
+func expandAzureADServicePrincipalPasswordCredential(d *schema.ResourceData) (*graphrbac.PasswordCredential, error) {
+	keyId := d.Get("key_id").(string)
+	if keyId == "" {
+		keyId = uuid.NewV4().String()
+	}
+
+	// the start date defaults to now
+	startDate := time.Now()
+	if v, ok := d.GetOk("start_date"); ok {
+		parsed, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `start_date` %q: %+v", v.(string), err)
+		}
+		startDate = parsed
+	}
+
+	endDate, err := time.Parse(time.RFC3339, d.Get("end_date").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing `end_date` %q: %+v", d.Get("end_date").(string), err)
+	}
+
+	if !endDate.After(startDate) {
+		return nil, fmt.Errorf("`end_date` must be after the `start_date`")
+	}
+
+	credential := graphrbac.PasswordCredential{
+		KeyID:     utils.String(keyId),
+		Value:     utils.String(d.Get("value").(string)),
+		StartDate: &date.Time{Time: startDate},
+		EndDate:   &date.Time{Time: endDate},
+	}
+
+	return &credential, nil
+}
+
+func parseAzureADServicePrincipalPasswordID(id string) (string, string, error) {
+	// example: 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Azure Active Directory Service Principal Password ID should be in the format {servicePrincipalObjectId}/{keyId}, got %q", id)
+	}
+
+	return parts[0], parts[1], nil
+}
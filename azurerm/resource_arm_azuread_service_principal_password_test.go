@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/satori/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAzureRMAzureADServicePrincipalPassword_parseID(t *testing.T) {
+	cases := []struct {
+		Input         string
+		ObjectID      string
+		KeyID         string
+		ExpectedError bool
+	}{
+		{
+			Input:         "",
+			ExpectedError: true,
+		},
+		{
+			Input:         "00000000-0000-0000-0000-000000000000",
+			ExpectedError: true,
+		},
+		{
+			Input:         "00000000-0000-0000-0000-000000000000/",
+			ExpectedError: true,
+		},
+		{
+			Input:         "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/extra",
+			ExpectedError: true,
+		},
+		{
+			Input:    "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111",
+			ObjectID: "00000000-0000-0000-0000-000000000000",
+			KeyID:    "11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	for _, tc := range cases {
+		objectId, keyId, err := parseAzureADServicePrincipalPasswordID(tc.Input)
+		if err != nil {
+			if tc.ExpectedError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectedError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if objectId != tc.ObjectID || keyId != tc.KeyID {
+			t.Fatalf("Expected %q / %q for ID '%s' but got %q / %q", tc.ObjectID, tc.KeyID, tc.Input, objectId, keyId)
+		}
+	}
+}
+
+func TestAccAzureRMAzureADServicePrincipalPassword_basic(t *testing.T) {
+	resourceName := "azurerm_azuread_service_principal_password.test"
+	id := acctest.RandString(8)
+	value := uuid.NewV4().String()
+	config := testAccAzureRMAzureADServicePrincipalPassword_basic(id, value)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADServicePrincipalPasswordExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2099-01-01T01:02:03Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAzureADServicePrincipalPassword_customKeyId(t *testing.T) {
+	resourceName := "azurerm_azuread_service_principal_password.test"
+	id := acctest.RandString(8)
+	keyId := uuid.NewV4().String()
+	value := uuid.NewV4().String()
+	config := testAccAzureRMAzureADServicePrincipalPassword_customKeyId(id, keyId, value)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADServicePrincipalPasswordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_id", keyId),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2099-01-01T01:02:03Z"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAzureADServicePrincipalPasswordExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		objectId, keyId, err := parseAzureADServicePrincipalPasswordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).servicePrincipalsClient
+		resp, err := client.ListPasswordCredentials(objectId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Azure AD Service Principal %q does not exist", objectId)
+			}
+			return fmt.Errorf("Bad: ListPasswordCredentials on Azure AD servicePrincipalsClient: %+v", err)
+		}
+
+		if resp.Value != nil {
+			for _, credential := range *resp.Value {
+				if credential.KeyID != nil && strings.EqualFold(*credential.KeyID, keyId) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: Password %q was not found for Azure AD Service Principal %q", keyId, objectId)
+	}
+}
+
+func testAccAzureRMAzureADServicePrincipalPassword_basic(id string, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name = "acctestspa%s"
+}
+
+resource "azurerm_azuread_service_principal" "test" {
+  application_id = "${azurerm_azuread_application.test.application_id}"
+}
+
+resource "azurerm_azuread_service_principal_password" "test" {
+  service_principal_id = "${azurerm_azuread_service_principal.test.id}"
+  value                = "%s"
+  end_date             = "2099-01-01T01:02:03Z"
+}
+`, id, value)
+}
+
+func testAccAzureRMAzureADServicePrincipalPassword_customKeyId(id string, keyId string, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name = "acctestspa%s"
+}
+
+resource "azurerm_azuread_service_principal" "test" {
+  application_id = "${azurerm_azuread_application.test.application_id}"
+}
+
+resource "azurerm_azuread_service_principal_password" "test" {
+  service_principal_id = "${azurerm_azuread_service_principal.test.id}"
+  key_id               = "%s"
+  value                = "%s"
+  end_date             = "2099-01-01T01:02:03Z"
+}
+`, id, keyId, value)
+}
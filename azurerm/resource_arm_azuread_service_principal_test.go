@@ -0,0 +1,89 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAzureADServicePrincipal_basic(t *testing.T) {
+	resourceName := "azurerm_azuread_service_principal.test"
+	id := acctest.RandString(8)
+	config := testAccAzureRMAzureADServicePrincipal_basic(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADServicePrincipalExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "azurerm_azuread_application.test", "application_id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAzureADServicePrincipalExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).servicePrincipalsClient
+		resp, err := client.Get(rs.Primary.ID)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Azure AD Service Principal %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("Bad: Get on Azure AD servicePrincipalsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAzureADServicePrincipalDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_azuread_service_principal" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).servicePrincipalsClient
+		resp, err := client.Get(rs.Primary.ID)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Azure AD Service Principal still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAzureADServicePrincipal_basic(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name = "acctestspa%s"
+}
+
+resource "azurerm_azuread_service_principal" "test" {
+  application_id = "${azurerm_azuread_application.test.application_id}"
+}
+`, id)
+}
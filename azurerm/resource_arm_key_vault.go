@@ -74,7 +74,7 @@ func resourceArmKeyVault() *schema.Resource {
 			"access_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MinItems: 1,
+				Computed: true,
 				MaxItems: 16,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -88,44 +88,14 @@ func resourceArmKeyVault() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validateUUID,
 						},
-						"key_permissions": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(keyvault.KeyPermissionsAll),
-									string(keyvault.KeyPermissionsBackup),
-									string(keyvault.KeyPermissionsCreate),
-									string(keyvault.KeyPermissionsDecrypt),
-									string(keyvault.KeyPermissionsDelete),
-									string(keyvault.KeyPermissionsEncrypt),
-									string(keyvault.KeyPermissionsGet),
-									string(keyvault.KeyPermissionsImport),
-									string(keyvault.KeyPermissionsList),
-									string(keyvault.KeyPermissionsRestore),
-									string(keyvault.KeyPermissionsSign),
-									string(keyvault.KeyPermissionsUnwrapKey),
-									string(keyvault.KeyPermissionsUpdate),
-									string(keyvault.KeyPermissionsVerify),
-									string(keyvault.KeyPermissionsWrapKey),
-								}, false),
-							},
-						},
-						"secret_permissions": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(keyvault.SecretPermissionsAll),
-									string(keyvault.SecretPermissionsDelete),
-									string(keyvault.SecretPermissionsGet),
-									string(keyvault.SecretPermissionsList),
-									string(keyvault.SecretPermissionsSet),
-								}, false),
-							},
+						"application_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateUUID,
 						},
+						"certificate_permissions": keyVaultCertificatePermissionsSchema(),
+						"key_permissions":         keyVaultKeyPermissionsSchema(),
+						"secret_permissions":      keyVaultSecretPermissionsSchema(),
 					},
 				},
 			},
@@ -163,12 +133,31 @@ func resourceArmKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
+	accessPolicies := expandKeyVaultAccessPolicies(d)
+
+	if !d.IsNewResource() {
+		// access policies can also be managed via the `azurerm_key_vault_access_policy` resource,
+		// so hold the vault lock whilst updating and only overwrite them when they've changed
+		azureRMLockByID(d.Id())
+		defer azureRMUnlockByID(d.Id())
+
+		if !d.HasChange("access_policy") {
+			existing, err := client.Get(resGroup, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving KeyVault %s (resource group %s): %+v", name, resGroup, err)
+			}
+			if props := existing.Properties; props != nil && props.AccessPolicies != nil {
+				accessPolicies = props.AccessPolicies
+			}
+		}
+	}
+
 	parameters := keyvault.VaultCreateOrUpdateParameters{
 		Location: &location,
 		Properties: &keyvault.VaultProperties{
 			TenantID:                     &tenantUUID,
 			Sku:                          expandKeyVaultSku(d),
-			AccessPolicies:               expandKeyVaultAccessPolicies(d),
+			AccessPolicies:               accessPolicies,
 			EnabledForDeployment:         &enabledForDeployment,
 			EnabledForDiskEncryption:     &enabledForDiskEncryption,
 			EnabledForTemplateDeployment: &enabledForTemplateDeployment,
@@ -221,7 +210,9 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("enabled_for_disk_encryption", resp.Properties.EnabledForDiskEncryption)
 	d.Set("enabled_for_template_deployment", resp.Properties.EnabledForTemplateDeployment)
 	d.Set("sku", flattenKeyVaultSku(resp.Properties.Sku))
-	d.Set("access_policy", flattenKeyVaultAccessPolicies(resp.Properties.AccessPolicies))
+	if err := d.Set("access_policy", flattenKeyVaultAccessPolicies(resp.Properties.AccessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `access_policy`: %+v", err)
+	}
 	d.Set("vault_uri", resp.Properties.VaultURI)
 
 	flattenAndSetTags(d, resp.Tags)
@@ -244,6 +235,75 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+func keyVaultCertificatePermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.All),
+				string(keyvault.Create),
+				string(keyvault.Delete),
+				string(keyvault.Deleteissuers),
+				string(keyvault.Get),
+				string(keyvault.Getissuers),
+				string(keyvault.Import),
+				string(keyvault.List),
+				string(keyvault.Listissuers),
+				string(keyvault.Managecontacts),
+				string(keyvault.Manageissuers),
+				string(keyvault.Setissuers),
+				string(keyvault.Update),
+			}, false),
+		},
+	}
+}
+
+func keyVaultKeyPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.KeyPermissionsAll),
+				string(keyvault.KeyPermissionsBackup),
+				string(keyvault.KeyPermissionsCreate),
+				string(keyvault.KeyPermissionsDecrypt),
+				string(keyvault.KeyPermissionsDelete),
+				string(keyvault.KeyPermissionsEncrypt),
+				string(keyvault.KeyPermissionsGet),
+				string(keyvault.KeyPermissionsImport),
+				string(keyvault.KeyPermissionsList),
+				string(keyvault.KeyPermissionsRestore),
+				string(keyvault.KeyPermissionsSign),
+				string(keyvault.KeyPermissionsUnwrapKey),
+				string(keyvault.KeyPermissionsUpdate),
+				string(keyvault.KeyPermissionsVerify),
+				string(keyvault.KeyPermissionsWrapKey),
+			}, false),
+		},
+	}
+}
+
+func keyVaultSecretPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.SecretPermissionsAll),
+				string(keyvault.SecretPermissionsDelete),
+				string(keyvault.SecretPermissionsGet),
+				string(keyvault.SecretPermissionsList),
+				string(keyvault.SecretPermissionsSet),
+			}, false),
+		},
+	}
+}
+
 func expandKeyVaultSku(d *schema.ResourceData) *keyvault.Sku {
 	skuSets := d.Get("sku").(*schema.Set).List()
 	sku := skuSets[0].(map[string]interface{})
@@ -260,35 +320,50 @@ func expandKeyVaultAccessPolicies(d *schema.ResourceData) *[]keyvault.AccessPoli
 
 	for _, policySet := range policies {
 		policyRaw := policySet.(map[string]interface{})
+		result = append(result, expandKeyVaultAccessPolicy(policyRaw))
+	}
 
-		keyPermissionsRaw := policyRaw["key_permissions"].([]interface{})
-		keyPermissions := []keyvault.KeyPermissions{}
-		for _, permission := range keyPermissionsRaw {
-			keyPermissions = append(keyPermissions, keyvault.KeyPermissions(permission.(string)))
-		}
+	return &result
+}
 
-		secretPermissionsRaw := policyRaw["secret_permissions"].([]interface{})
-		secretPermissions := []keyvault.SecretPermissions{}
-		for _, permission := range secretPermissionsRaw {
-			secretPermissions = append(secretPermissions, keyvault.SecretPermissions(permission.(string)))
-		}
+func expandKeyVaultAccessPolicy(policyRaw map[string]interface{}) keyvault.AccessPolicyEntry {
+	keyPermissionsRaw := policyRaw["key_permissions"].([]interface{})
+	keyPermissions := []keyvault.KeyPermissions{}
+	for _, permission := range keyPermissionsRaw {
+		keyPermissions = append(keyPermissions, keyvault.KeyPermissions(permission.(string)))
+	}
 
-		policy := keyvault.AccessPolicyEntry{
-			Permissions: &keyvault.Permissions{
-				Keys:    &keyPermissions,
-				Secrets: &secretPermissions,
-			},
-		}
+	secretPermissionsRaw := policyRaw["secret_permissions"].([]interface{})
+	secretPermissions := []keyvault.SecretPermissions{}
+	for _, permission := range secretPermissionsRaw {
+		secretPermissions = append(secretPermissions, keyvault.SecretPermissions(permission.(string)))
+	}
 
-		tenantUUID := uuid.FromStringOrNil(policyRaw["tenant_id"].(string))
-		policy.TenantID = &tenantUUID
-		objectUUID := policyRaw["object_id"].(string)
-		policy.ObjectID = &objectUUID
+	certificatePermissionsRaw := policyRaw["certificate_permissions"].([]interface{})
+	certificatePermissions := []keyvault.CertificatePermissions{}
+	for _, permission := range certificatePermissionsRaw {
+		certificatePermissions = append(certificatePermissions, keyvault.CertificatePermissions(permission.(string)))
+	}
 
-		result = append(result, policy)
+	policy := keyvault.AccessPolicyEntry{
+		Permissions: &keyvault.Permissions{
+			Certificates: &certificatePermissions,
+			Keys:         &keyPermissions,
+			Secrets:      &secretPermissions,
+		},
 	}
 
-	return &result
+	tenantUUID := uuid.FromStringOrNil(policyRaw["tenant_id"].(string))
+	policy.TenantID = &tenantUUID
+	objectUUID := policyRaw["object_id"].(string)
+	policy.ObjectID = &objectUUID
+
+	if v := policyRaw["application_id"]; v != nil && v.(string) != "" {
+		applicationUUID := uuid.FromStringOrNil(v.(string))
+		policy.ApplicationID = &applicationUUID
+	}
+
+	return policy
 }
 
 func flattenKeyVaultSku(sku *keyvault.Sku) []interface{} {
@@ -300,30 +375,58 @@ func flattenKeyVaultSku(sku *keyvault.Sku) []interface{} {
 }
 
 func flattenKeyVaultAccessPolicies(policies *[]keyvault.AccessPolicyEntry) []interface{} {
-	result := make([]interface{}, 0, len(*policies))
+	result := make([]interface{}, 0)
+	if policies == nil {
+		return result
+	}
 
 	for _, policy := range *policies {
-		policyRaw := make(map[string]interface{})
+		result = append(result, flattenKeyVaultAccessPolicy(policy))
+	}
+
+	return result
+}
+
+func flattenKeyVaultAccessPolicy(policy keyvault.AccessPolicyEntry) map[string]interface{} {
+	policyRaw := make(map[string]interface{})
+
+	keyPermissionsRaw := make([]interface{}, 0)
+	secretPermissionsRaw := make([]interface{}, 0)
+	certificatePermissionsRaw := make([]interface{}, 0)
+	if permissions := policy.Permissions; permissions != nil {
+		if keys := permissions.Keys; keys != nil {
+			for _, keyPermission := range *keys {
+				keyPermissionsRaw = append(keyPermissionsRaw, string(keyPermission))
+			}
+		}
 
-		keyPermissionsRaw := make([]interface{}, 0, len(*policy.Permissions.Keys))
-		for _, keyPermission := range *policy.Permissions.Keys {
-			keyPermissionsRaw = append(keyPermissionsRaw, string(keyPermission))
+		if secrets := permissions.Secrets; secrets != nil {
+			for _, secretPermission := range *secrets {
+				secretPermissionsRaw = append(secretPermissionsRaw, string(secretPermission))
+			}
 		}
 
-		secretPermissionsRaw := make([]interface{}, 0, len(*policy.Permissions.Secrets))
-		for _, secretPermission := range *policy.Permissions.Secrets {
-			secretPermissionsRaw = append(secretPermissionsRaw, string(secretPermission))
+		if certificates := permissions.Certificates; certificates != nil {
+			for _, certificatePermission := range *certificates {
+				certificatePermissionsRaw = append(certificatePermissionsRaw, string(certificatePermission))
+			}
 		}
+	}
 
+	if policy.TenantID != nil {
 		policyRaw["tenant_id"] = policy.TenantID.String()
+	}
+	if policy.ObjectID != nil {
 		policyRaw["object_id"] = *policy.ObjectID
-		policyRaw["key_permissions"] = keyPermissionsRaw
-		policyRaw["secret_permissions"] = secretPermissionsRaw
-
-		result = append(result, policyRaw)
 	}
+	if policy.ApplicationID != nil {
+		policyRaw["application_id"] = policy.ApplicationID.String()
+	}
+	policyRaw["certificate_permissions"] = certificatePermissionsRaw
+	policyRaw["key_permissions"] = keyPermissionsRaw
+	policyRaw["secret_permissions"] = secretPermissionsRaw
 
-	return result
+	return policyRaw
 }
 
 func validateKeyVaultName(v interface{}, k string) (ws []string, errors []error) {
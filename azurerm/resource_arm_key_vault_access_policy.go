@@ -0,0 +1,353 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/satori/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultAccessPolicyCreate,
+		Read:   resourceArmKeyVaultAccessPolicyRead,
+		Update: resourceArmKeyVaultAccessPolicyUpdate,
+		Delete: resourceArmKeyVaultAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultName,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"application_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"certificate_permissions": keyVaultCertificatePermissionsSchema(),
+
+			"key_permissions": keyVaultKeyPermissionsSchema(),
+
+			"secret_permissions": keyVaultSecretPermissionsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	log.Printf("[INFO] preparing arguments for Azure ARM KeyVault Access Policy creation.")
+
+	vaultName := d.Get("vault_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	vault, err := client.Get(resGroup, vaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving KeyVault %s (resource group %s): %+v", vaultName, resGroup, err)
+	}
+	if vault.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault %s (resource group %s) ID", vaultName, resGroup)
+	}
+	vaultId := *vault.ID
+
+	policyRaw := map[string]interface{}{
+		"tenant_id":               d.Get("tenant_id").(string),
+		"object_id":               d.Get("object_id").(string),
+		"application_id":          d.Get("application_id").(string),
+		"certificate_permissions": d.Get("certificate_permissions").([]interface{}),
+		"key_permissions":         d.Get("key_permissions").([]interface{}),
+		"secret_permissions":      d.Get("secret_permissions").([]interface{}),
+	}
+	policy := expandKeyVaultAccessPolicy(policyRaw)
+
+	azureRMLockByID(vaultId)
+	defer azureRMUnlockByID(vaultId)
+
+	// retrieve the vault again now the lock's held, since another policy may have been added in the meantime
+	vault, err = client.Get(resGroup, vaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving KeyVault %s (resource group %s): %+v", vaultName, resGroup, err)
+	}
+
+	policies := keyVaultAccessPolicies(vault)
+	if index := findKeyVaultAccessPolicy(policies, policy); index != -1 {
+		return fmt.Errorf("An Access Policy for Object ID %q already exists in KeyVault %s (resource group %s) - to be managed via Terraform this resource needs to be imported into the State", *policy.ObjectID, vaultName, resGroup)
+	}
+
+	policies = append(policies, policy)
+	if err := saveKeyVaultAccessPolicies(client, resGroup, vaultName, vault, policies); err != nil {
+		return err
+	}
+
+	d.SetId(keyVaultAccessPolicyID(vaultId, policy))
+
+	return resourceArmKeyVaultAccessPolicyRead(d, meta)
+}
+
+func resourceArmKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	log.Printf("[INFO] preparing arguments for Azure ARM KeyVault Access Policy update.")
+
+	id, err := parseKeyVaultAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyRaw := map[string]interface{}{
+		"tenant_id":               d.Get("tenant_id").(string),
+		"object_id":               id.ObjectID,
+		"application_id":          id.ApplicationID,
+		"certificate_permissions": d.Get("certificate_permissions").([]interface{}),
+		"key_permissions":         d.Get("key_permissions").([]interface{}),
+		"secret_permissions":      d.Get("secret_permissions").([]interface{}),
+	}
+	policy := expandKeyVaultAccessPolicy(policyRaw)
+
+	azureRMLockByID(id.KeyVaultID)
+	defer azureRMUnlockByID(id.KeyVaultID)
+
+	vault, err := client.Get(id.ResourceGroup, id.VaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving KeyVault %s (resource group %s): %+v", id.VaultName, id.ResourceGroup, err)
+	}
+
+	policies := keyVaultAccessPolicies(vault)
+	index := findKeyVaultAccessPolicy(policies, policy)
+	if index == -1 {
+		return fmt.Errorf("Access Policy for Object ID %q was not found in KeyVault %s (resource group %s)", id.ObjectID, id.VaultName, id.ResourceGroup)
+	}
+
+	policies[index] = policy
+	if err := saveKeyVaultAccessPolicies(client, id.ResourceGroup, id.VaultName, vault, policies); err != nil {
+		return err
+	}
+
+	return resourceArmKeyVaultAccessPolicyRead(d, meta)
+}
+
+func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(id.ResourceGroup, id.VaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] KeyVault %s (resource group %s) was not found - removing Access Policy from state", id.VaultName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure KeyVault %s: %+v", id.VaultName, err)
+	}
+
+	policies := keyVaultAccessPolicies(resp)
+	index := findKeyVaultAccessPolicy(policies, keyVaultAccessPolicyEntryFromID(id))
+	if index == -1 {
+		log.Printf("[DEBUG] Access Policy for Object ID %q was not found in KeyVault %s (resource group %s) - removing from state", id.ObjectID, id.VaultName, id.ResourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	policy := flattenKeyVaultAccessPolicy(policies[index])
+
+	d.Set("vault_name", id.VaultName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("tenant_id", policy["tenant_id"])
+	d.Set("object_id", policy["object_id"])
+	d.Set("application_id", policy["application_id"])
+	d.Set("certificate_permissions", policy["certificate_permissions"])
+	d.Set("key_permissions", policy["key_permissions"])
+	d.Set("secret_permissions", policy["secret_permissions"])
+
+	return nil
+}
+
+func resourceArmKeyVaultAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByID(id.KeyVaultID)
+	defer azureRMUnlockByID(id.KeyVaultID)
+
+	vault, err := client.Get(id.ResourceGroup, id.VaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(vault.Response) {
+			// the Access Policies are removed along with the Key Vault
+			return nil
+		}
+		return fmt.Errorf("Error retrieving KeyVault %s (resource group %s): %+v", id.VaultName, id.ResourceGroup, err)
+	}
+
+	policies := keyVaultAccessPolicies(vault)
+	index := findKeyVaultAccessPolicy(policies, keyVaultAccessPolicyEntryFromID(id))
+	if index == -1 {
+		return nil
+	}
+
+	policies = append(policies[:index], policies[index+1:]...)
+	return saveKeyVaultAccessPolicies(client, id.ResourceGroup, id.VaultName, vault, policies)
+}
+
+func keyVaultAccessPolicies(vault keyvault.Vault) []keyvault.AccessPolicyEntry {
+	if props := vault.Properties; props != nil && props.AccessPolicies != nil {
+		return *props.AccessPolicies
+	}
+
+	return make([]keyvault.AccessPolicyEntry, 0)
+}
+
+// saveKeyVaultAccessPolicies updates the Access Policies of an existing Key Vault. Since the 2015-06-01 API
+// doesn't expose an endpoint for patching the Access Policies the entire vault is re-submitted - as such
+// callers should hold the vault lock and retrieve the vault whilst holding it.
+func saveKeyVaultAccessPolicies(client keyvault.VaultsClient, resGroup string, name string, vault keyvault.Vault, policies []keyvault.AccessPolicyEntry) error {
+	if vault.Properties == nil {
+		return fmt.Errorf("Error retrieving KeyVault %s (resource group %s): `properties` was nil", name, resGroup)
+	}
+
+	if len(policies) > 16 {
+		return fmt.Errorf("KeyVault %s (resource group %s) can contain a maximum of 16 Access Policies", name, resGroup)
+	}
+
+	props := vault.Properties
+	props.AccessPolicies = &policies
+	parameters := keyvault.VaultCreateOrUpdateParameters{
+		Location:   vault.Location,
+		Properties: props,
+		Tags:       vault.Tags,
+	}
+
+	if _, err := client.CreateOrUpdate(resGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error updating the Access Policies for KeyVault %s (resource group %s): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+// findKeyVaultAccessPolicy returns the index of the Access Policy matching the Object ID, Application ID
+// and (when specified) the Tenant ID of the given policy, or -1 if it doesn't exist
+func findKeyVaultAccessPolicy(policies []keyvault.AccessPolicyEntry, policy keyvault.AccessPolicyEntry) int {
+	for i, existing := range policies {
+		if existing.ObjectID == nil || policy.ObjectID == nil || !strings.EqualFold(*existing.ObjectID, *policy.ObjectID) {
+			continue
+		}
+
+		if existing.TenantID != nil && policy.TenantID != nil && !uuid.Equal(*existing.TenantID, *policy.TenantID) {
+			continue
+		}
+
+		existingApplicationID := uuid.Nil
+		if existing.ApplicationID != nil {
+			existingApplicationID = *existing.ApplicationID
+		}
+		applicationID := uuid.Nil
+		if policy.ApplicationID != nil {
+			applicationID = *policy.ApplicationID
+		}
+		if !uuid.Equal(existingApplicationID, applicationID) {
+			continue
+		}
+
+		return i
+	}
+
+	return -1
+}
+
+type KeyVaultAccessPolicyID struct {
+	KeyVaultID    string
+	ResourceGroup string
+	VaultName     string
+	ObjectID      string
+	ApplicationID string
+}
+
+func keyVaultAccessPolicyID(vaultId string, policy keyvault.AccessPolicyEntry) string {
+	id := fmt.Sprintf("%s/objectId/%s", vaultId, *policy.ObjectID)
+	if policy.ApplicationID != nil {
+		id = fmt.Sprintf("%s/applicationId/%s", id, policy.ApplicationID.String())
+	}
+	return id
+}
+
+func parseKeyVaultAccessPolicyID(input string) (*KeyVaultAccessPolicyID, error) {
+	// example: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/objectId/11111111-1111-1111-1111-111111111111
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Access Policy Id: %+v", err)
+	}
+
+	vaultName := id.Path["vaults"]
+	if vaultName == "" {
+		return nil, fmt.Errorf("Azure KeyVault Access Policy Id should contain the `vaults` segment, got %q", input)
+	}
+
+	objectId := id.Path["objectId"]
+	if objectId == "" {
+		return nil, fmt.Errorf("Azure KeyVault Access Policy Id should contain the `objectId` segment, got %q", input)
+	}
+
+	index := strings.Index(input, "/objectId/")
+
+	policyId := KeyVaultAccessPolicyID{
+		KeyVaultID:    input[:index],
+		ResourceGroup: id.ResourceGroup,
+		VaultName:     vaultName,
+		ObjectID:      objectId,
+		ApplicationID: id.Path["applicationId"],
+	}
+
+	return &policyId, nil
+}
+
+// keyVaultAccessPolicyEntryFromID returns an Access Policy containing just the identifiers from the ID,
+// which can be used to find the matching Access Policy within the Key Vault
+func keyVaultAccessPolicyEntryFromID(id *KeyVaultAccessPolicyID) keyvault.AccessPolicyEntry {
+	policy := keyvault.AccessPolicyEntry{
+		ObjectID: utils.String(id.ObjectID),
+	}
+
+	if id.ApplicationID != "" {
+		applicationUUID := uuid.FromStringOrNil(id.ApplicationID)
+		policy.ApplicationID = &applicationUUID
+	}
+
+	return policy
+}
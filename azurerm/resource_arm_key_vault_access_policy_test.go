@@ -0,0 +1,437 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/keyvault"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/satori/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseKeyVaultAccessPolicyID(t *testing.T) {
+	vaultId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1"
+
+	cases := []struct {
+		Input         string
+		Expected      *KeyVaultAccessPolicyID
+		ExpectedError bool
+	}{
+		{
+			Input:         vaultId,
+			ExpectedError: true,
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/objectId/11111111-1111-1111-1111-111111111111",
+			ExpectedError: true,
+		},
+		{
+			Input: fmt.Sprintf("%s/objectId/11111111-1111-1111-1111-111111111111", vaultId),
+			Expected: &KeyVaultAccessPolicyID{
+				KeyVaultID:    vaultId,
+				ResourceGroup: "group1",
+				VaultName:     "vault1",
+				ObjectID:      "11111111-1111-1111-1111-111111111111",
+			},
+		},
+		{
+			Input: fmt.Sprintf("%s/objectId/11111111-1111-1111-1111-111111111111/applicationId/22222222-2222-2222-2222-222222222222", vaultId),
+			Expected: &KeyVaultAccessPolicyID{
+				KeyVaultID:    vaultId,
+				ResourceGroup: "group1",
+				VaultName:     "vault1",
+				ObjectID:      "11111111-1111-1111-1111-111111111111",
+				ApplicationID: "22222222-2222-2222-2222-222222222222",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := parseKeyVaultAccessPolicyID(tc.Input)
+		if err != nil {
+			if tc.ExpectedError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectedError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if *id != *tc.Expected {
+			t.Fatalf("Expected %+v for ID '%s' but got %+v", *tc.Expected, tc.Input, *id)
+		}
+	}
+}
+
+func TestFindKeyVaultAccessPolicy(t *testing.T) {
+	tenantId := uuid.FromStringOrNil("00000000-0000-0000-0000-000000000000")
+	otherTenantId := uuid.FromStringOrNil("99999999-9999-9999-9999-999999999999")
+	applicationId := uuid.FromStringOrNil("22222222-2222-2222-2222-222222222222")
+
+	policies := []keyvault.AccessPolicyEntry{
+		{
+			TenantID: &tenantId,
+			ObjectID: utils.String("11111111-1111-1111-1111-111111111111"),
+		},
+		{
+			TenantID:      &tenantId,
+			ObjectID:      utils.String("11111111-1111-1111-1111-111111111111"),
+			ApplicationID: &applicationId,
+		},
+		{
+			TenantID: &tenantId,
+			ObjectID: utils.String("33333333-3333-3333-3333-333333333333"),
+		},
+	}
+
+	cases := []struct {
+		Policy   keyvault.AccessPolicyEntry
+		Expected int
+	}{
+		{
+			Policy: keyvault.AccessPolicyEntry{
+				TenantID: &tenantId,
+				ObjectID: utils.String("11111111-1111-1111-1111-111111111111"),
+			},
+			Expected: 0,
+		},
+		{
+			Policy: keyvault.AccessPolicyEntry{
+				ObjectID:      utils.String("11111111-1111-1111-1111-111111111111"),
+				ApplicationID: &applicationId,
+			},
+			Expected: 1,
+		},
+		{
+			Policy: keyvault.AccessPolicyEntry{
+				ObjectID: utils.String("33333333-3333-3333-3333-333333333333"),
+			},
+			Expected: 2,
+		},
+		{
+			Policy: keyvault.AccessPolicyEntry{
+				TenantID: &otherTenantId,
+				ObjectID: utils.String("33333333-3333-3333-3333-333333333333"),
+			},
+			Expected: -1,
+		},
+		{
+			Policy: keyvault.AccessPolicyEntry{
+				ObjectID:      utils.String("33333333-3333-3333-3333-333333333333"),
+				ApplicationID: &applicationId,
+			},
+			Expected: -1,
+		},
+	}
+
+	for i, tc := range cases {
+		if index := findKeyVaultAccessPolicy(policies, tc.Policy); index != tc.Expected {
+			t.Fatalf("Expected index %d for case %d but got %d", tc.Expected, i, index)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policy.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.1", "set"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultAccessPolicy_multiple(t *testing.T) {
+	resourceName1 := "azurerm_key_vault_access_policy.test_with_application_id"
+	resourceName2 := "azurerm_key_vault_access_policy.test_no_application_id"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultAccessPolicy_multiple(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName1),
+					resource.TestCheckResourceAttr(resourceName1, "key_permissions.0", "create"),
+					resource.TestCheckResourceAttr(resourceName1, "key_permissions.1", "get"),
+					resource.TestCheckResourceAttr(resourceName1, "secret_permissions.0", "get"),
+					resource.TestCheckResourceAttr(resourceName1, "secret_permissions.1", "delete"),
+					resource.TestCheckResourceAttr(resourceName1, "certificate_permissions.0", "create"),
+					resource.TestCheckResourceAttr(resourceName1, "certificate_permissions.1", "delete"),
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName2, "key_permissions.0", "list"),
+					resource.TestCheckResourceAttr(resourceName2, "key_permissions.1", "encrypt"),
+					resource.TestCheckResourceAttr(resourceName2, "secret_permissions.0", "list"),
+					resource.TestCheckResourceAttr(resourceName2, "secret_permissions.1", "delete"),
+					resource.TestCheckResourceAttr(resourceName2, "certificate_permissions.0", "list"),
+					resource.TestCheckResourceAttr(resourceName2, "certificate_permissions.1", "delete"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultAccessPolicy_update(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policy.test"
+	rs := acctest.RandString(6)
+	preConfig := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
+	postConfig := testAccAzureRMKeyVaultAccessPolicy_update(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.1", "set"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.0", "list"),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.1", "encrypt"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultAccessPolicy_vaultUpdated(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policy.test"
+	rs := acctest.RandString(6)
+	preConfig := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
+	postConfig := testAccAzureRMKeyVaultAccessPolicy_vaultTagsUpdated(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+				),
+			},
+			{
+				// updating the Key Vault without an `access_policy` block shouldn't remove the Access Policy
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_key_vault.test", "tags.environment", "Staging"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultAccessPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseKeyVaultAccessPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+
+		resp, err := client.Get(id.ResourceGroup, id.VaultName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Vault %q (resource group: %q) does not exist", id.VaultName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultClient: %+v", err)
+		}
+
+		policies := keyVaultAccessPolicies(resp)
+		if index := findKeyVaultAccessPolicy(policies, keyVaultAccessPolicyEntryFromID(id)); index == -1 {
+			return fmt.Errorf("Bad: Access Policy for Object ID %q does not exist in Vault %q (resource group: %q)", id.ObjectID, id.VaultName, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location, "Production")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  object_id           = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+  key_permissions = [
+    "get",
+  ]
+
+  secret_permissions = [
+    "get",
+    "set",
+  ]
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_multiple(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location, "Production")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "test_with_application_id" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  object_id           = "${data.azurerm_client_config.current.service_principal_object_id}"
+  application_id      = "${data.azurerm_client_config.current.client_id}"
+
+  key_permissions = [
+    "create",
+    "get",
+  ]
+
+  secret_permissions = [
+    "get",
+    "delete",
+  ]
+
+  certificate_permissions = [
+    "create",
+    "delete",
+  ]
+}
+
+resource "azurerm_key_vault_access_policy" "test_no_application_id" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  object_id           = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+  key_permissions = [
+    "list",
+    "encrypt",
+  ]
+
+  secret_permissions = [
+    "list",
+    "delete",
+  ]
+
+  certificate_permissions = [
+    "list",
+    "delete",
+  ]
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_update(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location, "Production")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  object_id           = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+  key_permissions = [
+    "list",
+    "encrypt",
+  ]
+
+  secret_permissions = [
+    "get",
+  ]
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_vaultTagsUpdated(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location, "Staging")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  object_id           = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+  key_permissions = [
+    "get",
+  ]
+
+  secret_permissions = [
+    "get",
+    "set",
+  ]
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_template(rString string, location string, environment string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  tags {
+    environment = "%s"
+  }
+}
+`, rString, location, rString, environment)
+}
@@ -0,0 +1,733 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateCreate,
+		Read:   resourceArmKeyVaultCertificateRead,
+		Update: resourceArmKeyVaultCertificateUpdate,
+		Delete: resourceArmKeyVaultCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultSecretName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contents": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validateKeyVaultCertificateContents,
+						},
+
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"certificate_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer_parameters": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+
+						"key_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exportable": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+
+									"key_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateIntInSlice([]int{2048, 3072, 4096}),
+									},
+
+									"key_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(keyvault.RSA),
+											string(keyvault.RSAHSM),
+										}, false),
+									},
+
+									"reuse_key": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+
+						"lifetime_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(keyvault.AutoRenew),
+														string(keyvault.EmailContacts),
+													}, false),
+												},
+											},
+										},
+									},
+
+									"trigger": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days_before_expiry": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+
+												"lifetime_percentage": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 99),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"secret_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"application/x-pkcs12",
+											"application/x-pem-file",
+										}, false),
+									},
+								},
+							},
+						},
+
+						"x509_certificate_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"extended_key_usage": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"key_usage": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(keyvault.CRLSign),
+												string(keyvault.DataEncipherment),
+												string(keyvault.DecipherOnly),
+												string(keyvault.DigitalSignature),
+												string(keyvault.EncipherOnly),
+												string(keyvault.KeyAgreement),
+												string(keyvault.KeyCertSign),
+												string(keyvault.KeyEncipherment),
+												string(keyvault.NonRepudiation),
+											}, false),
+										},
+									},
+
+									"subject": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+
+									"subject_alternative_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dns_names": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+
+												"emails": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+
+												"upns": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+
+									"validity_in_months": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Certificate creation.")
+
+	name := d.Get("name").(string)
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	policy := expandKeyVaultCertificatePolicy(d)
+
+	if v, ok := d.GetOk("certificate"); ok {
+		certificates := v.([]interface{})
+		certificate := certificates[0].(map[string]interface{})
+
+		parameters := keyvault.CertificateImportParameters{
+			Base64EncodedCertificate: utils.String(certificate["contents"].(string)),
+			CertificatePolicy:        policy,
+			Tags:                     expandTags(tags),
+		}
+
+		if password := certificate["password"].(string); password != "" {
+			parameters.Password = utils.String(password)
+		}
+
+		if _, err := client.ImportCertificate(keyVaultBaseUrl, name, parameters); err != nil {
+			return fmt.Errorf("Error importing KeyVault Certificate '%s' (in key vault '%s'): %+v", name, keyVaultBaseUrl, err)
+		}
+	} else {
+		parameters := keyvault.CertificateCreateParameters{
+			CertificatePolicy: policy,
+			Tags:              expandTags(tags),
+		}
+
+		if _, err := client.CreateCertificate(keyVaultBaseUrl, name, parameters); err != nil {
+			return fmt.Errorf("Error creating KeyVault Certificate '%s' (in key vault '%s'): %+v", name, keyVaultBaseUrl, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for KeyVault Certificate '%s' (in key vault '%s') to be issued", name, keyVaultBaseUrl)
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"inProgress"},
+			Target:     []string{"completed"},
+			Refresh:    keyVaultCertificateOperationStateRefreshFunc(client, keyVaultBaseUrl, name),
+			Timeout:    60 * time.Minute,
+			MinTimeout: 15 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for KeyVault Certificate '%s' (in key vault '%s') to be issued: %+v", name, keyVaultBaseUrl, err)
+		}
+	}
+
+	// "" indicates the latest version
+	read, err := client.GetCertificate(keyVaultBaseUrl, name, "")
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Certificate '%s' (in key vault '%s')", name, keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultCertificateRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Certificate update.")
+
+	id, err := parseKeyVaultSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	parameters := keyvault.CertificateUpdateParameters{
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.UpdateCertificate(id.KeyVaultBaseUrl, id.Name, id.Version, parameters); err != nil {
+		return err
+	}
+
+	return resourceArmKeyVaultCertificateRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	id, err := parseKeyVaultSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// we always want to get the latest version
+	resp, err := client.GetCertificate(id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure KeyVault Certificate %s: %+v", id.Name, err)
+	}
+
+	// the version may have changed, so parse the updated id
+	respID, err := parseKeyVaultSecretID(*resp.ID)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", respID.Name)
+	d.Set("vault_uri", respID.KeyVaultBaseUrl)
+	d.Set("version", respID.Version)
+	d.Set("secret_id", resp.Sid)
+
+	if err := d.Set("certificate_policy", flattenKeyVaultCertificatePolicy(resp.Policy)); err != nil {
+		return fmt.Errorf("Error flattening `certificate_policy`: %+v", err)
+	}
+
+	if resp.X509Thumbprint != nil {
+		thumbprint, err := keyVaultCertificateThumbprintToHex(*resp.X509Thumbprint)
+		if err != nil {
+			return fmt.Errorf("Error decoding the thumbprint of KeyVault Certificate %s: %+v", id.Name, err)
+		}
+		d.Set("thumbprint", thumbprint)
+	}
+
+	if resp.Cer != nil {
+		d.Set("certificate_data", strings.ToUpper(hex.EncodeToString(*resp.Cer)))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+	return nil
+}
+
+func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	id, err := parseKeyVaultSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteCertificate(id.KeyVaultBaseUrl, id.Name)
+
+	return err
+}
+
+func keyVaultCertificateOperationStateRefreshFunc(client keyvault.ManagementClient, keyVaultBaseUrl string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		operation, err := client.GetCertificateOperation(keyVaultBaseUrl, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error issuing read request in keyVaultCertificateOperationStateRefreshFunc for KeyVault Certificate '%s' (in key vault '%s'): %+v", name, keyVaultBaseUrl, err)
+		}
+
+		if operation.Error != nil && operation.Error.Message != nil {
+			return nil, "", fmt.Errorf("Error issuing KeyVault Certificate '%s' (in key vault '%s'): %s", name, keyVaultBaseUrl, *operation.Error.Message)
+		}
+
+		status := ""
+		if operation.Status != nil {
+			status = *operation.Status
+		}
+
+		return operation, status, nil
+	}
+}
+
+func expandKeyVaultCertificatePolicy(d *schema.ResourceData) *keyvault.CertificatePolicy {
+	policies := d.Get("certificate_policy").([]interface{})
+	policyRaw := policies[0].(map[string]interface{})
+	policy := keyvault.CertificatePolicy{}
+
+	issuers := policyRaw["issuer_parameters"].([]interface{})
+	issuer := issuers[0].(map[string]interface{})
+	policy.IssuerParameters = &keyvault.IssuerParameters{
+		Name: utils.String(issuer["name"].(string)),
+	}
+
+	properties := policyRaw["key_properties"].([]interface{})
+	props := properties[0].(map[string]interface{})
+	policy.KeyProperties = &keyvault.KeyProperties{
+		Exportable: utils.Bool(props["exportable"].(bool)),
+		KeySize:    utils.Int32(int32(props["key_size"].(int))),
+		KeyType:    utils.String(props["key_type"].(string)),
+		ReuseKey:   utils.Bool(props["reuse_key"].(bool)),
+	}
+
+	lifetimeActions := make([]keyvault.LifetimeAction, 0)
+	for _, v := range policyRaw["lifetime_action"].([]interface{}) {
+		action := v.(map[string]interface{})
+		lifetimeAction := keyvault.LifetimeAction{}
+
+		if v, ok := action["action"]; ok {
+			actions := v.([]interface{})
+			if len(actions) > 0 && actions[0] != nil {
+				config := actions[0].(map[string]interface{})
+				lifetimeAction.Action = &keyvault.Action{
+					ActionType: keyvault.ActionType(config["action_type"].(string)),
+				}
+			}
+		}
+
+		if v, ok := action["trigger"]; ok {
+			triggers := v.([]interface{})
+			if len(triggers) > 0 && triggers[0] != nil {
+				config := triggers[0].(map[string]interface{})
+				trigger := keyvault.Trigger{}
+
+				if days := config["days_before_expiry"].(int); days > 0 {
+					trigger.DaysBeforeExpiry = utils.Int32(int32(days))
+				}
+
+				if percentage := config["lifetime_percentage"].(int); percentage > 0 {
+					trigger.LifetimePercentage = utils.Int32(int32(percentage))
+				}
+
+				lifetimeAction.Trigger = &trigger
+			}
+		}
+
+		lifetimeActions = append(lifetimeActions, lifetimeAction)
+	}
+	policy.LifetimeActions = &lifetimeActions
+
+	secrets := policyRaw["secret_properties"].([]interface{})
+	secret := secrets[0].(map[string]interface{})
+	policy.SecretProperties = &keyvault.SecretProperties{
+		ContentType: utils.String(secret["content_type"].(string)),
+	}
+
+	certificateProperties := policyRaw["x509_certificate_properties"].([]interface{})
+	if len(certificateProperties) > 0 && certificateProperties[0] != nil {
+		cert := certificateProperties[0].(map[string]interface{})
+
+		extendedKeyUsage := make([]string, 0)
+		for _, v := range cert["extended_key_usage"].([]interface{}) {
+			extendedKeyUsage = append(extendedKeyUsage, v.(string))
+		}
+
+		keyUsage := make([]keyvault.KeyUsageType, 0)
+		for _, v := range cert["key_usage"].(*schema.Set).List() {
+			keyUsage = append(keyUsage, keyvault.KeyUsageType(v.(string)))
+		}
+
+		x509Properties := keyvault.X509CertificateProperties{
+			Ekus:             &extendedKeyUsage,
+			KeyUsage:         &keyUsage,
+			Subject:          utils.String(cert["subject"].(string)),
+			ValidityInMonths: utils.Int32(int32(cert["validity_in_months"].(int))),
+		}
+
+		names := cert["subject_alternative_names"].([]interface{})
+		if len(names) > 0 && names[0] != nil {
+			sans := names[0].(map[string]interface{})
+			x509Properties.SubjectAlternativeNames = &keyvault.SubjectAlternativeNames{
+				DNSNames: expandKeyVaultCertificateStringList(sans["dns_names"].([]interface{})),
+				Emails:   expandKeyVaultCertificateStringList(sans["emails"].([]interface{})),
+				Upns:     expandKeyVaultCertificateStringList(sans["upns"].([]interface{})),
+			}
+		}
+
+		policy.X509CertificateProperties = &x509Properties
+	}
+
+	return &policy
+}
+
+func expandKeyVaultCertificateStringList(input []interface{}) *[]string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return &output
+}
+
+func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	policy := make(map[string]interface{}, 0)
+
+	if params := input.IssuerParameters; params != nil {
+		issuerParams := make(map[string]interface{}, 0)
+		if params.Name != nil {
+			issuerParams["name"] = *params.Name
+		}
+		policy["issuer_parameters"] = []interface{}{issuerParams}
+	}
+
+	if props := input.KeyProperties; props != nil {
+		keyProps := make(map[string]interface{}, 0)
+		if props.Exportable != nil {
+			keyProps["exportable"] = *props.Exportable
+		}
+		if props.KeySize != nil {
+			keyProps["key_size"] = int(*props.KeySize)
+		}
+		if props.KeyType != nil {
+			keyProps["key_type"] = *props.KeyType
+		}
+		if props.ReuseKey != nil {
+			keyProps["reuse_key"] = *props.ReuseKey
+		}
+		policy["key_properties"] = []interface{}{keyProps}
+	}
+
+	lifetimeActions := make([]interface{}, 0)
+	if actions := input.LifetimeActions; actions != nil {
+		for _, action := range *actions {
+			lifetimeAction := make(map[string]interface{}, 0)
+
+			if act := action.Action; act != nil {
+				lifetimeAction["action"] = []interface{}{
+					map[string]interface{}{
+						"action_type": string(act.ActionType),
+					},
+				}
+			}
+
+			if trigger := action.Trigger; trigger != nil {
+				triggerOutput := make(map[string]interface{}, 0)
+				if trigger.DaysBeforeExpiry != nil {
+					triggerOutput["days_before_expiry"] = int(*trigger.DaysBeforeExpiry)
+				}
+				if trigger.LifetimePercentage != nil {
+					triggerOutput["lifetime_percentage"] = int(*trigger.LifetimePercentage)
+				}
+				lifetimeAction["trigger"] = []interface{}{triggerOutput}
+			}
+
+			lifetimeActions = append(lifetimeActions, lifetimeAction)
+		}
+	}
+	policy["lifetime_action"] = lifetimeActions
+
+	if props := input.SecretProperties; props != nil {
+		secretProps := make(map[string]interface{}, 0)
+		if props.ContentType != nil {
+			secretProps["content_type"] = *props.ContentType
+		}
+		policy["secret_properties"] = []interface{}{secretProps}
+	}
+
+	if props := input.X509CertificateProperties; props != nil {
+		certProps := make(map[string]interface{}, 0)
+
+		extendedKeyUsage := make([]interface{}, 0)
+		if props.Ekus != nil {
+			for _, usage := range *props.Ekus {
+				extendedKeyUsage = append(extendedKeyUsage, usage)
+			}
+		}
+		certProps["extended_key_usage"] = extendedKeyUsage
+
+		keyUsage := make([]interface{}, 0)
+		if props.KeyUsage != nil {
+			for _, usage := range *props.KeyUsage {
+				keyUsage = append(keyUsage, string(usage))
+			}
+		}
+		certProps["key_usage"] = keyUsage
+
+		if props.Subject != nil {
+			certProps["subject"] = *props.Subject
+		}
+
+		if props.ValidityInMonths != nil {
+			certProps["validity_in_months"] = int(*props.ValidityInMonths)
+		}
+
+		if sans := props.SubjectAlternativeNames; sans != nil {
+			certProps["subject_alternative_names"] = []interface{}{
+				map[string]interface{}{
+					"dns_names": flattenKeyVaultCertificateStringList(sans.DNSNames),
+					"emails":    flattenKeyVaultCertificateStringList(sans.Emails),
+					"upns":      flattenKeyVaultCertificateStringList(sans.Upns),
+				},
+			}
+		}
+
+		policy["x509_certificate_properties"] = []interface{}{certProps}
+	}
+
+	return append(results, policy)
+}
+
+func flattenKeyVaultCertificateStringList(input *[]string) []interface{} {
+	output := make([]interface{}, 0)
+	if input != nil {
+		for _, v := range *input {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+// keyVaultCertificateThumbprintToHex converts the Base64 URL encoded thumbprint returned from the
+// Key Vault API into the hex encoded format used elsewhere in Azure (e.g. App Services & VM's)
+func keyVaultCertificateThumbprintToHex(input string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(input, "="))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(hex.EncodeToString(decoded)), nil
+}
+
+func validateKeyVaultCertificateContents(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !isBase64Encoded(value) {
+		errors = append(errors, fmt.Errorf("%q must be a base64 encoded PFX or PEM file", k))
+	}
+
+	return
+}
@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultCertificateContacts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateContactsCreateUpdate,
+		Read:   resourceArmKeyVaultCertificateContactsRead,
+		Update: resourceArmKeyVaultCertificateContactsCreateUpdate,
+		Delete: resourceArmKeyVaultCertificateContactsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"phone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateContactsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Certificate Contacts creation.")
+
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+
+	contacts := keyvault.Contacts{
+		ContactList: expandKeyVaultCertificateContacts(d),
+	}
+
+	if _, err := client.SetCertificateContacts(keyVaultBaseUrl, contacts); err != nil {
+		return fmt.Errorf("Error setting KeyVault Certificate Contacts (in key vault '%s'): %+v", keyVaultBaseUrl, err)
+	}
+
+	read, err := client.GetCertificateContacts(keyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Certificate Contacts (in key vault '%s')", keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultCertificateContactsRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateContactsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	keyVaultBaseUrl, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetCertificateContacts(keyVaultBaseUrl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure KeyVault Certificate Contacts (in key vault '%s'): %+v", keyVaultBaseUrl, err)
+	}
+
+	d.Set("vault_uri", keyVaultBaseUrl)
+
+	if err := d.Set("contact", flattenKeyVaultCertificateContacts(resp.ContactList)); err != nil {
+		return fmt.Errorf("Error flattening `contact`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultCertificateContactsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	keyVaultBaseUrl, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteCertificateContacts(keyVaultBaseUrl)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting KeyVault Certificate Contacts (in key vault '%s'): %+v", keyVaultBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func expandKeyVaultCertificateContacts(d *schema.ResourceData) *[]keyvault.Contact {
+	contacts := d.Get("contact").([]interface{})
+	results := make([]keyvault.Contact, 0)
+
+	for _, v := range contacts {
+		contact := v.(map[string]interface{})
+
+		result := keyvault.Contact{
+			EmailAddress: utils.String(contact["email_address"].(string)),
+		}
+
+		if name := contact["name"].(string); name != "" {
+			result.Name = utils.String(name)
+		}
+
+		if phone := contact["phone"].(string); phone != "" {
+			result.Phone = utils.String(phone)
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenKeyVaultCertificateContacts(input *[]keyvault.Contact) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, contact := range *input {
+		result := make(map[string]interface{}, 0)
+
+		if contact.EmailAddress != nil {
+			result["email_address"] = *contact.EmailAddress
+		}
+
+		if contact.Name != nil {
+			result["name"] = *contact.Name
+		}
+
+		if contact.Phone != nil {
+			result["phone"] = *contact.Phone
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// parseKeyVaultCertificateContactsID returns the Key Vault Base URL, since the Contacts are a singleton within each Key Vault
+func parseKeyVaultCertificateContactsID(id string) (string, error) {
+	// example: https://tharvey-keyvault.vault.azure.net/certificates/contacts
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return "", fmt.Errorf("Cannot parse Azure KeyVault Certificate Contacts Id: %s", err)
+	}
+
+	path := strings.Trim(strings.TrimSpace(idURL.Path), "/")
+	if path != "certificates/contacts" {
+		return "", fmt.Errorf("Azure KeyVault Certificate Contacts Id should be in the format `/certificates/contacts`, got '%s'", path)
+	}
+
+	return fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host), nil
+}
@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultCertificateContacts_parseID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates",
+			ExpectError: true,
+		},
+		{
+			Input:    "https://my-keyvault.vault.azure.net/certificates/contacts",
+			Expected: "https://my-keyvault.vault.azure.net/",
+		},
+	}
+
+	for _, tc := range cases {
+		baseUrl, err := parseKeyVaultCertificateContactsID(tc.Input)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+			}
+			continue
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if tc.Expected != baseUrl {
+			t.Fatalf("Expected the Key Vault Base URL to be '%s', got '%s' for ID '%s'", tc.Expected, baseUrl, tc.Input)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultCertificateContacts_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_contacts.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+	config := testAccAzureRMKeyVaultCertificateContacts_basic(rs, location)
+	updatedConfig := testAccAzureRMKeyVaultCertificateContacts_multiple(rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateContactsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateContactsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateContactsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "contact.1.name", "Second Contact"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultCertificateContactsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_certificate_contacts" {
+			continue
+		}
+
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		resp, err := client.GetCertificateContacts(vaultBaseUrl)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Key Vault Certificate Contacts still exist:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultCertificateContactsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+
+		resp, err := client.GetCertificateContacts(vaultBaseUrl)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Key Vault Certificate Contacts (vault: %q) do not exist", vaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultCertificateContacts_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificate_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_contacts" "test" {
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  contact {
+    email_address = "first@contoso.com"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultCertificateContacts_multiple(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificate_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_contacts" "test" {
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  contact {
+    email_address = "first@contoso.com"
+    name          = "First Contact"
+  }
+
+  contact {
+    email_address = "second@contoso.com"
+    name          = "Second Contact"
+    phone         = "01234567890"
+  }
+}
+`, template)
+}
@@ -0,0 +1,276 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultCertificateIssuer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateIssuerCreateUpdate,
+		Read:   resourceArmKeyVaultCertificateIssuerRead,
+		Update: resourceArmKeyVaultCertificateIssuerCreateUpdate,
+		Delete: resourceArmKeyVaultCertificateIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultSecretName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"org_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"admin": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"phone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateIssuerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Certificate Issuer creation.")
+
+	name := d.Get("name").(string)
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+
+	parameters := keyvault.CertificateIssuerSetParameters{
+		Provider: utils.String(d.Get("provider_name").(string)),
+		OrganizationDetails: &keyvault.OrganizationDetails{
+			AdminDetails: expandKeyVaultCertificateIssuerAdmins(d),
+		},
+	}
+
+	if v := d.Get("org_id").(string); v != "" {
+		parameters.OrganizationDetails.ID = utils.String(v)
+	}
+
+	accountId := d.Get("account_id").(string)
+	password := d.Get("password").(string)
+	if accountId != "" || password != "" {
+		parameters.Credentials = &keyvault.IssuerCredentials{
+			AccountID: utils.String(accountId),
+			Password:  utils.String(password),
+		}
+	}
+
+	if _, err := client.SetCertificateIssuer(keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("Error setting KeyVault Certificate Issuer '%s' (in key vault '%s'): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	read, err := client.GetCertificateIssuer(keyVaultBaseUrl, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Certificate Issuer '%s' (in key vault '%s')", name, keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultCertificateIssuerRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	id, err := parseKeyVaultCertificateIssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetCertificateIssuer(id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure KeyVault Certificate Issuer %s: %+v", id.Name, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseUrl)
+	d.Set("provider_name", resp.Provider)
+
+	// the password isn't returned from the API, so we use the value from the config
+	if credentials := resp.Credentials; credentials != nil {
+		d.Set("account_id", credentials.AccountID)
+	}
+
+	admins := make([]interface{}, 0)
+	if org := resp.OrganizationDetails; org != nil {
+		d.Set("org_id", org.ID)
+		admins = flattenKeyVaultCertificateIssuerAdmins(org.AdminDetails)
+	}
+	if err := d.Set("admin", admins); err != nil {
+		return fmt.Errorf("Error flattening `admin`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultCertificateIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	id, err := parseKeyVaultCertificateIssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteCertificateIssuer(id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting KeyVault Certificate Issuer %s: %+v", id.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func expandKeyVaultCertificateIssuerAdmins(d *schema.ResourceData) *[]keyvault.AdministratorDetails {
+	admins := d.Get("admin").([]interface{})
+	results := make([]keyvault.AdministratorDetails, 0)
+
+	for _, v := range admins {
+		admin := v.(map[string]interface{})
+
+		details := keyvault.AdministratorDetails{
+			EmailAddress: utils.String(admin["email_address"].(string)),
+		}
+
+		if firstName := admin["first_name"].(string); firstName != "" {
+			details.FirstName = utils.String(firstName)
+		}
+
+		if lastName := admin["last_name"].(string); lastName != "" {
+			details.LastName = utils.String(lastName)
+		}
+
+		if phone := admin["phone"].(string); phone != "" {
+			details.Phone = utils.String(phone)
+		}
+
+		results = append(results, details)
+	}
+
+	return &results
+}
+
+func flattenKeyVaultCertificateIssuerAdmins(input *[]keyvault.AdministratorDetails) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, admin := range *input {
+		result := make(map[string]interface{}, 0)
+
+		if admin.EmailAddress != nil {
+			result["email_address"] = *admin.EmailAddress
+		}
+
+		if admin.FirstName != nil {
+			result["first_name"] = *admin.FirstName
+		}
+
+		if admin.LastName != nil {
+			result["last_name"] = *admin.LastName
+		}
+
+		if admin.Phone != nil {
+			result["phone"] = *admin.Phone
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+type KeyVaultCertificateIssuerID struct {
+	KeyVaultBaseUrl string
+	Name            string
+}
+
+func parseKeyVaultCertificateIssuerID(id string) (*KeyVaultCertificateIssuerID, error) {
+	// example: https://tharvey-keyvault.vault.azure.net/certificates/issuers/digicert
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Certificate Issuer Id: %s", err)
+	}
+
+	path := strings.Trim(strings.TrimSpace(idURL.Path), "/")
+	components := strings.Split(path, "/")
+
+	if len(components) != 3 || components[0] != "certificates" || components[1] != "issuers" {
+		return nil, fmt.Errorf("Azure KeyVault Certificate Issuer Id should be in the format `/certificates/issuers/{name}`, got '%s'", path)
+	}
+
+	issuerID := KeyVaultCertificateIssuerID{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Name:            components[2],
+	}
+
+	return &issuerID, nil
+}
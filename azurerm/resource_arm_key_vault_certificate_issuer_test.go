@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultCertificateIssuer_parseID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    KeyVaultCertificateIssuerID
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/issuers",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/issuers/digicert",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/certificates/issuers/digicert",
+			Expected: KeyVaultCertificateIssuerID{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+				Name:            "digicert",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		issuerId, err := parseKeyVaultCertificateIssuerID(tc.Input)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+			}
+			continue
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if tc.Expected.KeyVaultBaseUrl != issuerId.KeyVaultBaseUrl {
+			t.Fatalf("Expected 'KeyVaultBaseUrl' to be '%s', got '%s' for ID '%s'", tc.Expected.KeyVaultBaseUrl, issuerId.KeyVaultBaseUrl, tc.Input)
+		}
+
+		if tc.Expected.Name != issuerId.Name {
+			t.Fatalf("Expected 'Name' to be '%s', got '%s' for ID '%s'", tc.Expected.Name, issuerId.Name, tc.Input)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultCertificateIssuer_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_issuer.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultCertificateIssuer_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "OneCert"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultCertificateIssuer_complete(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_issuer.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+	config := testAccAzureRMKeyVaultCertificateIssuer_basic(rs, location)
+	updatedConfig := testAccAzureRMKeyVaultCertificateIssuer_complete(rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", "test-account"),
+					resource.TestCheckResourceAttr(resourceName, "org_id", "accTestOrg"),
+					resource.TestCheckResourceAttr(resourceName, "admin.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.email_address", "admin@contoso.com"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultCertificateIssuerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_certificate_issuer" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		resp, err := client.GetCertificateIssuer(vaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Key Vault Certificate Issuer still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultCertificateIssuerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+
+		resp, err := client.GetCertificateIssuer(vaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Key Vault Certificate Issuer %q (vault: %q) does not exist", name, vaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificate_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_issuer" "test" {
+  name          = "acctestissuer%s"
+  vault_uri     = "${azurerm_key_vault.test.vault_uri}"
+  provider_name = "OneCert"
+}
+`, template, rString)
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_complete(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificate_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_issuer" "test" {
+  name          = "acctestissuer%s"
+  vault_uri     = "${azurerm_key_vault.test.vault_uri}"
+  provider_name = "OneCert"
+  account_id    = "test-account"
+  password      = "test-password"
+  org_id        = "accTestOrg"
+
+  admin {
+    email_address = "admin@contoso.com"
+    first_name    = "First"
+    last_name     = "Last"
+    phone         = "01234567890"
+  }
+}
+`, template, rString)
+}
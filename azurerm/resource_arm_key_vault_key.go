@@ -0,0 +1,330 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultKeyCreate,
+		Read:   resourceArmKeyVaultKeyRead,
+		Update: resourceArmKeyVaultKeyUpdate,
+		Delete: resourceArmKeyVaultKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultSecretName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// changing the type, size or key material creates a new version of the key
+			"key_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.EC),
+					string(keyvault.RSA),
+					string(keyvault.RSAHSM),
+				}, false),
+			},
+
+			"key_size": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateIntInSlice([]int{1024, 2048, 3072, 4096}),
+				ConflictsWith: []string{"key_jwk"},
+			},
+
+			"key_jwk": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.ValidateJsonString,
+				ConflictsWith: []string{"key_size"},
+			},
+
+			"key_opts": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(keyvault.Decrypt),
+						string(keyvault.Encrypt),
+						string(keyvault.Sign),
+						string(keyvault.UnwrapKey),
+						string(keyvault.Verify),
+						string(keyvault.WrapKey),
+					}, false),
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"n": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"e": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Key creation.")
+
+	name := d.Get("name").(string)
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+
+	if err := createKeyVaultKeyVersion(d, client, keyVaultBaseUrl, name); err != nil {
+		return err
+	}
+
+	// "" indicates the latest version
+	read, err := client.GetKey(keyVaultBaseUrl, name, "")
+	if err != nil {
+		return err
+	}
+	if read.Key == nil || read.Key.Kid == nil {
+		return fmt.Errorf("Cannot read KeyVault Key '%s' (in key vault '%s')", name, keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.Key.Kid)
+
+	return resourceArmKeyVaultKeyRead(d, meta)
+}
+
+func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Key update.")
+
+	id, err := parseKeyVaultSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("key_type") || d.HasChange("key_size") || d.HasChange("key_jwk") {
+		// rotating the key material requires creating a new version of the key
+		if err := createKeyVaultKeyVersion(d, client, id.KeyVaultBaseUrl, id.Name); err != nil {
+			return err
+		}
+
+		// "" indicates the latest version
+		read, err := client.GetKey(id.KeyVaultBaseUrl, id.Name, "")
+		if err != nil {
+			return err
+		}
+		if read.Key == nil || read.Key.Kid == nil {
+			return fmt.Errorf("Cannot read KeyVault Key '%s' (in key vault '%s')", id.Name, id.KeyVaultBaseUrl)
+		}
+
+		// the ID is suffixed with the key version
+		d.SetId(*read.Key.Kid)
+	} else {
+		tags := d.Get("tags").(map[string]interface{})
+		parameters := keyvault.KeyUpdateParameters{
+			KeyOps: expandKeyVaultKeyOptions(d),
+			Tags:   expandTags(tags),
+		}
+
+		_, err = client.UpdateKey(id.KeyVaultBaseUrl, id.Name, id.Version, parameters)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceArmKeyVaultKeyRead(d, meta)
+}
+
+func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	id, err := parseKeyVaultSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// we always want to get the latest version
+	resp, err := client.GetKey(id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure KeyVault Key %s: %+v", id.Name, err)
+	}
+
+	if key := resp.Key; key != nil {
+		// the version may have changed, so parse the updated id
+		respID, err := parseKeyVaultSecretID(*key.Kid)
+		if err != nil {
+			return err
+		}
+
+		d.Set("name", respID.Name)
+		d.Set("vault_uri", respID.KeyVaultBaseUrl)
+		d.Set("version", respID.Version)
+		d.Set("key_type", string(key.Kty))
+		d.Set("n", key.N)
+		d.Set("e", key.E)
+
+		// the key size isn't returned from the API, but can be determined from the modulus of RSA keys
+		if key.N != nil {
+			size, err := keyVaultKeySizeFromModulus(*key.N)
+			if err != nil {
+				return fmt.Errorf("Error determining the size of KeyVault Key %s: %+v", id.Name, err)
+			}
+			d.Set("key_size", size)
+		}
+
+		keyOpts := make([]string, 0)
+		if key.KeyOps != nil {
+			keyOpts = *key.KeyOps
+		}
+		if err := d.Set("key_opts", keyOpts); err != nil {
+			return fmt.Errorf("Error flattening `key_opts`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+	return nil
+}
+
+func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	id, err := parseKeyVaultSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteKey(id.KeyVaultBaseUrl, id.Name)
+
+	return err
+}
+
+// createKeyVaultKeyVersion either generates a new key or imports the specified JSON Web Key - both of which
+// create a new version of the key when one with this name already exists
+func createKeyVaultKeyVersion(d *schema.ResourceData, client keyvault.ManagementClient, keyVaultBaseUrl string, name string) error {
+	keyType := d.Get("key_type").(string)
+	keyOpts := expandKeyVaultKeyOptions(d)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if v, ok := d.GetOk("key_jwk"); ok {
+		key, err := expandKeyVaultKeyJSONWebKey(v.(string), keyType, keyOpts)
+		if err != nil {
+			return err
+		}
+
+		parameters := keyvault.KeyImportParameters{
+			Hsm:  utils.Bool(keyType == string(keyvault.RSAHSM)),
+			Key:  key,
+			Tags: expandTags(tags),
+		}
+
+		if _, err := client.ImportKey(keyVaultBaseUrl, name, parameters); err != nil {
+			return fmt.Errorf("Error importing KeyVault Key '%s' (in key vault '%s'): %+v", name, keyVaultBaseUrl, err)
+		}
+
+		return nil
+	}
+
+	parameters := keyvault.KeyCreateParameters{
+		Kty:    keyvault.JSONWebKeyType(keyType),
+		KeyOps: keyOpts,
+		Tags:   expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("key_size"); ok {
+		if keyType == string(keyvault.EC) {
+			return fmt.Errorf("`key_size` can only be specified for RSA keys")
+		}
+		parameters.KeySize = utils.Int32(int32(v.(int)))
+	}
+
+	if _, err := client.CreateKey(keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("Error creating KeyVault Key '%s' (in key vault '%s'): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func expandKeyVaultKeyOptions(d *schema.ResourceData) *[]keyvault.JSONWebKeyOperation {
+	options := d.Get("key_opts").([]interface{})
+	results := make([]keyvault.JSONWebKeyOperation, 0, len(options))
+
+	for _, option := range options {
+		results = append(results, keyvault.JSONWebKeyOperation(option.(string)))
+	}
+
+	return &results
+}
+
+func expandKeyVaultKeyJSONWebKey(input string, keyType string, keyOpts *[]keyvault.JSONWebKeyOperation) (*keyvault.JSONWebKey, error) {
+	var key keyvault.JSONWebKey
+	if err := json.Unmarshal([]byte(input), &key); err != nil {
+		return nil, fmt.Errorf("Error parsing `key_jwk`: %+v", err)
+	}
+
+	if key.Kty != "" && !strings.HasPrefix(keyType, string(key.Kty)) {
+		return nil, fmt.Errorf("Error: the `kty` of `key_jwk` (%q) doesn't match the `key_type` %q", string(key.Kty), keyType)
+	}
+	key.Kty = keyvault.JSONWebKeyType(keyType)
+
+	// the key operations are managed via `key_opts` rather than within the JSON Web Key
+	operations := make([]string, 0)
+	for _, operation := range *keyOpts {
+		operations = append(operations, string(operation))
+	}
+	key.KeyOps = &operations
+
+	return &key, nil
+}
+
+// keyVaultKeySizeFromModulus returns the size of an RSA key in bits, given the
+// Base64 URL encoded modulus returned from the Key Vault API
+func keyVaultKeySizeFromModulus(modulus string) (int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(modulus, "="))
+	if err != nil {
+		return 0, err
+	}
+
+	// strip any leading zero bytes, which aren't part of the key
+	for len(decoded) > 0 && decoded[0] == 0 {
+		decoded = decoded[1:]
+	}
+
+	return len(decoded) * 8, nil
+}
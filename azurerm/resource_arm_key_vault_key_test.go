@@ -0,0 +1,461 @@
+package azurerm
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultKey_keySizeFromModulus(t *testing.T) {
+	cases := []struct {
+		Input    []byte
+		Expected int
+	}{
+		{
+			Input:    make([]byte, 256),
+			Expected: 0,
+		},
+		{
+			Input:    append([]byte{0x00}, testKeyVaultKeyModulusOfLength(256)...),
+			Expected: 2048,
+		},
+		{
+			Input:    testKeyVaultKeyModulusOfLength(512),
+			Expected: 4096,
+		},
+	}
+
+	for _, tc := range cases {
+		modulus := base64.RawURLEncoding.EncodeToString(tc.Input)
+		size, err := keyVaultKeySizeFromModulus(modulus)
+		if err != nil {
+			t.Fatalf("Expected no error for modulus of %d bytes but got: %+v", len(tc.Input), err)
+		}
+
+		if size != tc.Expected {
+			t.Fatalf("Expected a key size of %d for modulus of %d bytes but got %d", tc.Expected, len(tc.Input), size)
+		}
+	}
+
+	if _, err := keyVaultKeySizeFromModulus("not*base64"); err == nil {
+		t.Fatalf("Expected an error for an invalid modulus but didn't get one")
+	}
+}
+
+func TestAccAzureRMKeyVaultKey_expandJSONWebKey(t *testing.T) {
+	keyOpts := []keyvault.JSONWebKeyOperation{keyvault.Sign, keyvault.Verify}
+
+	key, err := expandKeyVaultKeyJSONWebKey(`{"kty": "RSA", "n": "abc", "e": "AQAB", "key_ops": ["encrypt"]}`, "RSA-HSM", &keyOpts)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if key.Kty != keyvault.RSAHSM {
+		t.Fatalf("Expected the key type to be %q but got %q", keyvault.RSAHSM, key.Kty)
+	}
+
+	if key.N == nil || *key.N != "abc" {
+		t.Fatalf("Expected the modulus to be parsed from the JSON Web Key")
+	}
+
+	if len(*key.KeyOps) != 2 || (*key.KeyOps)[0] != "sign" || (*key.KeyOps)[1] != "verify" {
+		t.Fatalf("Expected the key operations to be taken from `key_opts` but got %+v", *key.KeyOps)
+	}
+
+	if _, err := expandKeyVaultKeyJSONWebKey(`{"kty": "EC"}`, "RSA", &keyOpts); err == nil {
+		t.Fatalf("Expected an error for a mismatched key type but didn't get one")
+	}
+}
+
+func TestAccAzureRMKeyVaultKey_basicEC(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultKey_basicEC(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "EC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultKey_basicRSA(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultKey_basicRSA(rs, testLocation(), "RSA")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "key_opts.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "e", "AQAB"),
+					resource.TestCheckResourceAttrSet(resourceName, "n"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultKey_basicRSAHSM(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultKey_basicRSA(rs, testLocation(), "RSA-HSM")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "RSA-HSM"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultKey_complete(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultKey_complete(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "4096"),
+					resource.TestCheckResourceAttr(resourceName, "key_opts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.hello", "world"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultKey_updatedRotation(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+	config := testAccAzureRMKeyVaultKey_basicRSA(rs, location, "RSA")
+	updatedConfig := testAccAzureRMKeyVaultKey_complete(rs, location)
+
+	var firstVersion string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					testCheckAzureRMKeyVaultKeyVersion(resourceName, &firstVersion, false),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					testCheckAzureRMKeyVaultKeyVersion(resourceName, &firstVersion, true),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "4096"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultKey_importJWK(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	jwk := testAccAzureRMKeyVaultKey_generateJWK(t)
+	config := testAccAzureRMKeyVaultKey_importJWK(rs, testLocation(), jwk)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "RSA"),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "2048"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_key" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		// get the latest version
+		resp, err := client.GetKey(vaultBaseUrl, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Key Vault Key still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultKeyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+
+		resp, err := client.GetKey(vaultBaseUrl, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Key Vault Key %q (vault: %q) does not exist", name, vaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKeyVaultKeyVersion(name string, version *string, expectChanged bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		current := rs.Primary.Attributes["version"]
+		if !expectChanged {
+			*version = current
+			return nil
+		}
+
+		if current == *version {
+			return fmt.Errorf("Bad: expected a new version of the Key Vault Key to be created but it's still %q", current)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultKey_generateJWK(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating RSA Key: %+v", err)
+	}
+	key.Precompute()
+
+	encode := func(i *big.Int) string {
+		return base64.RawURLEncoding.EncodeToString(i.Bytes())
+	}
+
+	jwk := map[string]string{
+		"kty": "RSA",
+		"n":   encode(key.N),
+		"e":   encode(big.NewInt(int64(key.E))),
+		"d":   encode(key.D),
+		"p":   encode(key.Primes[0]),
+		"q":   encode(key.Primes[1]),
+		"dp":  encode(key.Precomputed.Dp),
+		"dq":  encode(key.Precomputed.Dq),
+		"qi":  encode(key.Precomputed.Qinv),
+	}
+
+	output, err := json.Marshal(jwk)
+	if err != nil {
+		t.Fatalf("Error serializing JSON Web Key: %+v", err)
+	}
+
+	return string(output)
+}
+
+func testKeyVaultKeyModulusOfLength(length int) []byte {
+	output := make([]byte, length)
+	for i := range output {
+		output[i] = 0xff
+	}
+	return output
+}
+
+func testAccAzureRMKeyVaultKey_template(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "all",
+    ]
+
+    secret_permissions = [
+      "all",
+    ]
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rString, location, rString)
+}
+
+func testAccAzureRMKeyVaultKey_basicEC(rString string, location string) string {
+	template := testAccAzureRMKeyVaultKey_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "EC"
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+`, template, rString)
+}
+
+func testAccAzureRMKeyVaultKey_basicRSA(rString string, location string, keyType string) string {
+	template := testAccAzureRMKeyVaultKey_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "%s"
+  key_size  = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, template, rString, keyType)
+}
+
+func testAccAzureRMKeyVaultKey_complete(rString string, location string) string {
+	template := testAccAzureRMKeyVaultKey_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 4096
+
+  key_opts = [
+    "unwrapKey",
+    "wrapKey",
+  ]
+
+  tags {
+    "hello" = "world"
+  }
+}
+`, template, rString)
+}
+
+func testAccAzureRMKeyVaultKey_importJWK(rString string, location string, jwk string) string {
+	template := testAccAzureRMKeyVaultKey_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+
+  key_jwk = <<JWK
+%s
+JWK
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+}
+`, template, rString, jwk)
+}
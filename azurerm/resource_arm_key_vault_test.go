@@ -110,6 +110,7 @@ func TestAccAzureRMKeyVault_update(t *testing.T) {
 			{
 				Config: postConfig,
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.certificate_permissions.0", "get"),
 					resource.TestCheckResourceAttr(resourceName, "access_policy.0.key_permissions.0", "get"),
 					resource.TestCheckResourceAttr(resourceName, "access_policy.0.secret_permissions.0", "get"),
 					resource.TestCheckResourceAttr(resourceName, "enabled_for_deployment", "true"),
@@ -238,6 +239,10 @@ resource "azurerm_key_vault" "test" {
     tenant_id = "${data.azurerm_client_config.current.tenant_id}"
     object_id = "${data.azurerm_client_config.current.client_id}"
 
+    certificate_permissions = [
+      "get",
+    ]
+
     key_permissions = [
       "get",
     ]
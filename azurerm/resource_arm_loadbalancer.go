@@ -114,8 +114,31 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
+	loadBalancerID := networkResourceID(client.subscriptionId, resGroup, "loadBalancers", name)
+	azureRMLockByID(loadBalancerID)
+	defer azureRMUnlockByID(loadBalancerID)
+
 	properties := network.LoadBalancerPropertiesFormat{}
 
+	if !d.IsNewResource() {
+		// the Backend Address Pools, Rules, Probes and NAT Rules/Pools are managed by their own
+		// resources (e.g. `azurerm_lb_rule`) - so we need to retain these when updating the Load Balancer
+		existing, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
+		if err != nil {
+			return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+		}
+
+		if exists && existing.LoadBalancerPropertiesFormat != nil {
+			props := existing.LoadBalancerPropertiesFormat
+			properties.BackendAddressPools = props.BackendAddressPools
+			properties.LoadBalancingRules = props.LoadBalancingRules
+			properties.Probes = props.Probes
+			properties.InboundNatRules = props.InboundNatRules
+			properties.InboundNatPools = props.InboundNatPools
+			properties.OutboundNatRules = props.OutboundNatRules
+		}
+	}
+
 	if _, ok := d.GetOk("frontend_ip_configuration"); ok {
 		properties.FrontendIPConfigurations = expandAzureRmLoadBalancerFrontendIpConfigurations(d)
 	}
@@ -174,8 +197,16 @@ func resourecArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("name", loadBalancer.Name)
-	d.Set("location", azureRMNormalizeLocation(*loadBalancer.Location))
 	d.Set("resource_group_name", id.ResourceGroup)
+	flattenAndSetLoadBalancer(d, loadBalancer)
+
+	return nil
+}
+
+// flattenAndSetLoadBalancer sets the properties of the Load Balancer which are common to both
+// the resource and the data source
+func flattenAndSetLoadBalancer(d *schema.ResourceData, loadBalancer *network.LoadBalancer) {
+	d.Set("location", azureRMNormalizeLocation(*loadBalancer.Location))
 
 	if loadBalancer.LoadBalancerPropertiesFormat != nil && loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations != nil {
 		ipconfigs := loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations
@@ -192,8 +223,6 @@ func resourecArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	flattenAndSetTags(d, loadBalancer.Tags)
-
-	return nil
 }
 
 func resourceArmLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
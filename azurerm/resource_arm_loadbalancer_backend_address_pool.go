@@ -3,11 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -60,75 +58,39 @@ func resourceArmLoadBalancerBackendAddressPool() *schema.Resource {
 }
 
 func resourceArmLoadBalancerBackendAddressPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
+	err := applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("Backend Address Pool %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		backendAddressPools := append(*loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools, expandAzureRmLoadBalancerBackendAddressPools(d))
 
-	backendAddressPools := append(*loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools, expandAzureRmLoadBalancerBackendAddressPools(d))
-	existingPool, existingPoolIndex, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingPool.Name {
-			// this pool is being updated/reapplied remove old copy from the slice
-			backendAddressPools = append(backendAddressPools[:existingPoolIndex], backendAddressPools[existingPoolIndex+1:]...)
+		_, existingIndex, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, name)
+		if exists {
+			// this backend address pool is being updated/reapplied remove old copy from the slice
+			backendAddressPools = append(backendAddressPools[:existingIndex], backendAddressPools[existingIndex+1:]...)
 		}
-	}
 
-	loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &backendAddressPools
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
+		loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &backendAddressPools
+		return nil
+	})
 	if err != nil {
-		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+		return err
 	}
 
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	read, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
 	}
-
-	var pool_id string
-	for _, BackendAddressPool := range *(*read.LoadBalancerPropertiesFormat).BackendAddressPools {
-		if *BackendAddressPool.Name == d.Get("name").(string) {
-			pool_id = *BackendAddressPool.ID
-		}
+	if !exists {
+		return fmt.Errorf("Cannot read LoadBalancer %q", loadBalancerID)
 	}
 
-	if pool_id != "" {
-		d.SetId(pool_id)
-	} else {
-		return fmt.Errorf("Cannot find created LoadBalancer Backend Address Pool ID %q", pool_id)
+	created, _, exists := findLoadBalancerBackEndAddressPoolByName(read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find created LoadBalancer Backend Address Pool %q", name)
 	}
 
-	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Accepted", "Updating"},
-		Target:  []string{"Succeeded"},
-		Refresh: loadbalancerStateRefreshFunc(client, resGroup, loadBalancerName),
-		Timeout: 10 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
-	}
+	d.SetId(*created.ID)
 
 	return resourceArmLoadBalancerBackendAddressPoolRead(d, meta)
 }
@@ -181,12 +143,8 @@ func resourceArmLoadBalancerBackendAddressPoolRead(d *schema.ResourceData, meta
 }
 
 func resourceArmLoadBalancerBackendAddressPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
 	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
@@ -197,35 +155,21 @@ func resourceArmLoadBalancerBackendAddressPoolDelete(d *schema.ResourceData, met
 		return nil
 	}
 
-	_, index, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+	if _, _, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, name); !exists {
 		return nil
 	}
 
-	oldBackEndPools := *loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools
-	newBackEndPools := append(oldBackEndPools[:index], oldBackEndPools[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &newBackEndPools
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
-	if err != nil {
-		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
-	}
-
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
-	}
+	return applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("Backend Address Pool %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	return nil
+		oldBackendAddressPools := *loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools
+		newBackendAddressPools := append(oldBackendAddressPools[:index], oldBackendAddressPools[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.BackendAddressPools = &newBackendAddressPools
+		return nil
+	})
 }
 
 func expandAzureRmLoadBalancerBackendAddressPools(d *schema.ResourceData) network.BackendAddressPool {
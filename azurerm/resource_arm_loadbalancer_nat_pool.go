@@ -3,11 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -79,81 +77,44 @@ func resourceArmLoadBalancerNatPool() *schema.Resource {
 }
 
 func resourceArmLoadBalancerNatPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	newNatPool, err := expandAzureRmLoadBalancerNatPool(d, loadBalancer)
-	if err != nil {
-		return errwrap.Wrapf("Error Expanding NAT Pool {{err}}", err)
-	}
+	err := applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("NAT Pool %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		newNatPool, err := expandAzureRmLoadBalancerNatPool(d, loadBalancer)
+		if err != nil {
+			return errwrap.Wrapf("Error Expanding NAT Pool {{err}}", err)
+		}
 
-	natPools := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools, *newNatPool)
+		natPools := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools, *newNatPool)
 
-	existingNatPool, existingNatPoolIndex, exists := findLoadBalancerNatPoolByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingNatPool.Name {
-			// this probe is being updated/reapplied remove old copy from the slice
-			natPools = append(natPools[:existingNatPoolIndex], natPools[existingNatPoolIndex+1:]...)
+		_, existingIndex, exists := findLoadBalancerNatPoolByName(loadBalancer, name)
+		if exists {
+			// this nat pool is being updated/reapplied remove old copy from the slice
+			natPools = append(natPools[:existingIndex], natPools[existingIndex+1:]...)
 		}
-	}
 
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &natPools
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &natPools
+		return nil
+	})
 	if err != nil {
-		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+		return err
 	}
 
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	read, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
 	}
-
-	var natPool_id string
-	for _, InboundNatPool := range *(*read.LoadBalancerPropertiesFormat).InboundNatPools {
-		if *InboundNatPool.Name == d.Get("name").(string) {
-			natPool_id = *InboundNatPool.ID
-		}
+	if !exists {
+		return fmt.Errorf("Cannot read LoadBalancer %q", loadBalancerID)
 	}
 
-	if natPool_id != "" {
-		d.SetId(natPool_id)
-	} else {
-		return fmt.Errorf("Cannot find created LoadBalancer NAT Pool ID %q", natPool_id)
+	created, _, exists := findLoadBalancerNatPoolByName(read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find created LoadBalancer NAT Pool %q", name)
 	}
 
-	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Accepted", "Updating"},
-		Target:  []string{"Succeeded"},
-		Refresh: loadbalancerStateRefreshFunc(client, resGroup, loadBalancerName),
-		Timeout: 10 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
-	}
+	d.SetId(*created.ID)
 
 	return resourceArmLoadBalancerNatPoolRead(d, meta)
 }
@@ -203,12 +164,8 @@ func resourceArmLoadBalancerNatPoolRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmLoadBalancerNatPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
 	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
@@ -219,35 +176,21 @@ func resourceArmLoadBalancerNatPoolDelete(d *schema.ResourceData, meta interface
 		return nil
 	}
 
-	_, index, exists := findLoadBalancerNatPoolByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+	if _, _, exists := findLoadBalancerNatPoolByName(loadBalancer, name); !exists {
 		return nil
 	}
 
-	oldNatPools := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools
-	newNatPools := append(oldNatPools[:index], oldNatPools[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &newNatPools
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
-	if err != nil {
-		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
-	}
-
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
-	}
+	return applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("NAT Pool %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerNatPoolByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	return nil
+		oldInboundNatPools := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools
+		newInboundNatPools := append(oldInboundNatPools[:index], oldInboundNatPools[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &newInboundNatPools
+		return nil
+	})
 }
 
 func expandAzureRmLoadBalancerNatPool(d *schema.ResourceData, lb *network.LoadBalancer) (*network.InboundNatPool, error) {
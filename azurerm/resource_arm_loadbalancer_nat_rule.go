@@ -3,11 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -79,81 +77,44 @@ func resourceArmLoadBalancerNatRule() *schema.Resource {
 }
 
 func resourceArmLoadBalancerNatRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	newNatRule, err := expandAzureRmLoadBalancerNatRule(d, loadBalancer)
-	if err != nil {
-		return errwrap.Wrapf("Error Expanding NAT Rule {{err}}", err)
-	}
+	err := applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("NAT Rule %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		newNatRule, err := expandAzureRmLoadBalancerNatRule(d, loadBalancer)
+		if err != nil {
+			return errwrap.Wrapf("Error Expanding NAT Rule {{err}}", err)
+		}
 
-	natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
+		natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
 
-	existingNatRule, existingNatRuleIndex, exists := findLoadBalancerNatRuleByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingNatRule.Name {
-			// this probe is being updated/reapplied remove old copy from the slice
-			natRules = append(natRules[:existingNatRuleIndex], natRules[existingNatRuleIndex+1:]...)
+		_, existingIndex, exists := findLoadBalancerNatRuleByName(loadBalancer, name)
+		if exists {
+			// this nat rule is being updated/reapplied remove old copy from the slice
+			natRules = append(natRules[:existingIndex], natRules[existingIndex+1:]...)
 		}
-	}
 
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
+		return nil
+	})
 	if err != nil {
-		return errwrap.Wrapf("Error Creating / Updating LoadBalancer {{err}}", err)
+		return err
 	}
 
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	read, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
 	}
-
-	var natRule_id string
-	for _, InboundNatRule := range *(*read.LoadBalancerPropertiesFormat).InboundNatRules {
-		if *InboundNatRule.Name == d.Get("name").(string) {
-			natRule_id = *InboundNatRule.ID
-		}
+	if !exists {
+		return fmt.Errorf("Cannot read LoadBalancer %q", loadBalancerID)
 	}
 
-	if natRule_id != "" {
-		d.SetId(natRule_id)
-	} else {
-		return fmt.Errorf("Cannot find created LoadBalancer NAT Rule ID %q", natRule_id)
+	created, _, exists := findLoadBalancerNatRuleByName(read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find created LoadBalancer NAT Rule %q", name)
 	}
 
-	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Accepted", "Updating"},
-		Target:  []string{"Succeeded"},
-		Refresh: loadbalancerStateRefreshFunc(client, resGroup, loadBalancerName),
-		Timeout: 10 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
-	}
+	d.SetId(*created.ID)
 
 	return resourceArmLoadBalancerNatRuleRead(d, meta)
 }
@@ -206,12 +167,8 @@ func resourceArmLoadBalancerNatRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmLoadBalancerNatRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
 	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
@@ -222,35 +179,21 @@ func resourceArmLoadBalancerNatRuleDelete(d *schema.ResourceData, meta interface
 		return nil
 	}
 
-	_, index, exists := findLoadBalancerNatRuleByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+	if _, _, exists := findLoadBalancerNatRuleByName(loadBalancer, name); !exists {
 		return nil
 	}
 
-	oldNatRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
-	newNatRules := append(oldNatRules[:index], oldNatRules[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &newNatRules
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
-	if err != nil {
-		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
-	}
-
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
-	}
+	return applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("NAT Rule %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerNatRuleByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	return nil
+		oldInboundNatRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
+		newInboundNatRules := append(oldInboundNatRules[:index], oldInboundNatRules[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &newInboundNatRules
+		return nil
+	})
 }
 
 func expandAzureRmLoadBalancerNatRule(d *schema.ResourceData, lb *network.LoadBalancer) (*network.InboundNatRule, error) {
@@ -3,11 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -84,81 +82,44 @@ func resourceArmLoadBalancerProbe() *schema.Resource {
 }
 
 func resourceArmLoadBalancerProbeCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	newProbe, err := expandAzureRmLoadBalancerProbe(d, loadBalancer)
-	if err != nil {
-		return errwrap.Wrapf("Error Expanding Probe {{err}}", err)
-	}
+	err := applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("Probe %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		newProbe, err := expandAzureRmLoadBalancerProbe(d, loadBalancer)
+		if err != nil {
+			return errwrap.Wrapf("Error Expanding Probe {{err}}", err)
+		}
 
-	probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
+		probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
 
-	existingProbe, existingProbeIndex, exists := findLoadBalancerProbeByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingProbe.Name {
+		_, existingIndex, exists := findLoadBalancerProbeByName(loadBalancer, name)
+		if exists {
 			// this probe is being updated/reapplied remove old copy from the slice
-			probes = append(probes[:existingProbeIndex], probes[existingProbeIndex+1:]...)
+			probes = append(probes[:existingIndex], probes[existingIndex+1:]...)
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
 
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
+		return nil
+	})
 	if err != nil {
-		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+		return err
 	}
 
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	read, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
 	}
-
-	var createdProbe_id string
-	for _, Probe := range *(*read.LoadBalancerPropertiesFormat).Probes {
-		if *Probe.Name == d.Get("name").(string) {
-			createdProbe_id = *Probe.ID
-		}
+	if !exists {
+		return fmt.Errorf("Cannot read LoadBalancer %q", loadBalancerID)
 	}
 
-	if createdProbe_id != "" {
-		d.SetId(createdProbe_id)
-	} else {
-		return fmt.Errorf("Cannot find created LoadBalancer Probe ID %q", createdProbe_id)
+	created, _, exists := findLoadBalancerProbeByName(read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find created LoadBalancer Probe %q", name)
 	}
 
-	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Accepted", "Updating"},
-		Target:  []string{"Succeeded"},
-		Refresh: loadbalancerStateRefreshFunc(client, resGroup, loadBalancerName),
-		Timeout: 10 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
-	}
+	d.SetId(*created.ID)
 
 	return resourceArmLoadBalancerProbeRead(d, meta)
 }
@@ -207,12 +168,8 @@ func resourceArmLoadBalancerProbeRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmLoadBalancerProbeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	lbClient := client.loadBalancerClient
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
 	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
 	if err != nil {
@@ -223,35 +180,21 @@ func resourceArmLoadBalancerProbeDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	_, index, exists := findLoadBalancerProbeByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+	if _, _, exists := findLoadBalancerProbeByName(loadBalancer, name); !exists {
 		return nil
 	}
 
-	oldProbes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
-	newProbes := append(oldProbes[:index], oldProbes[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &newProbes
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
-	}
-
-	_, error := lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
-	err = <-error
-	if err != nil {
-		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
-	}
-
-	read, err := lbClient.Get(resGroup, loadBalancerName, "")
-	if err != nil {
-		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
-	}
+	return applyLoadBalancerChange(loadBalancerID, fmt.Sprintf("Probe %q", name), meta, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerProbeByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	return nil
+		oldProbes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
+		newProbes := append(oldProbes[:index], oldProbes[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &newProbes
+		return nil
+	})
 }
 
 func expandAzureRmLoadBalancerProbe(d *schema.ResourceData, lb *network.LoadBalancer) (*network.Probe, error) {
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/Azure/azure-sdk-for-go/arm/disk"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -65,9 +66,10 @@ func resourceArmManagedDisk() *schema.Resource {
 			},
 
 			"source_resource_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
 			"os_type": {
@@ -151,8 +153,45 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 
 	createDisk.CreationData = creationData
 
+	// growing a Disk which is attached to a running Virtual Machine requires the Virtual Machine to be deallocated
+	virtualMachineId := ""
+	shouldStartVirtualMachine := false
+	if !d.IsNewResource() && d.HasChange("disk_size_gb") {
+		oldSize, newSize := d.GetChange("disk_size_gb")
+		if newSize.(int) < oldSize.(int) {
+			return fmt.Errorf("[ERROR] Managed Disk %q (resource group %q) can only be grown - `disk_size_gb` cannot be reduced from %d to %d", name, resGroup, oldSize.(int), newSize.(int))
+		}
+
+		existing, err := diskClient.Get(resGroup, name)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving Managed Disk %q (resource group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.Properties; props != nil && props.OwnerID != nil && *props.OwnerID != "" {
+			virtualMachineId = *props.OwnerID
+			azureRMLockByID(virtualMachineId)
+			defer azureRMUnlockByID(virtualMachineId)
+
+			shouldStartVirtualMachine, err = deallocateArmVirtualMachineForDiskResize(meta, virtualMachineId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	_, diskErr := diskClient.CreateOrUpdate(resGroup, name, createDisk, make(chan struct{}))
 	err := <-diskErr
+
+	// the Virtual Machine is started again even if the resize failed, rather than leaving it deallocated
+	if shouldStartVirtualMachine {
+		if startErr := startArmVirtualMachineAfterDiskResize(meta, virtualMachineId); startErr != nil {
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error resizing Managed Disk %q (resource group %q): %+v\n\n%+v", name, resGroup, err, startErr)
+			}
+			return startErr
+		}
+	}
+
 	if err != nil {
 		return err
 	}
@@ -240,4 +279,67 @@ func flattenAzureRmManagedDiskCreationData(d *schema.ResourceData, creationData
 	if creationData.SourceURI != nil {
 		d.Set("source_uri", *creationData.SourceURI)
 	}
+	if creationData.SourceResourceID != nil {
+		d.Set("source_resource_id", *creationData.SourceResourceID)
+	}
+}
+
+// deallocateArmVirtualMachineForDiskResize deallocates the Virtual Machine if it's running, returning whether it
+// should be started again once the Disk has been resized
+func deallocateArmVirtualMachineForDiskResize(meta interface{}, virtualMachineId string) (bool, error) {
+	vmClient := meta.(*ArmClient).vmClient
+
+	id, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return false, err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["virtualMachines"]
+
+	vm, err := vmClient.Get(resGroup, name, compute.InstanceView)
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error retrieving Virtual Machine %q (resource group %q): %+v", name, resGroup, err)
+	}
+
+	powerState := ""
+	if props := vm.VirtualMachineProperties; props != nil && props.InstanceView != nil && props.InstanceView.Statuses != nil {
+		for _, status := range *props.InstanceView.Statuses {
+			if status.Code != nil && strings.HasPrefix(strings.ToLower(*status.Code), "powerstate/") {
+				powerState = strings.TrimPrefix(strings.ToLower(*status.Code), "powerstate/")
+				break
+			}
+		}
+	}
+
+	if powerState == "deallocated" || powerState == "deallocating" {
+		return false, nil
+	}
+
+	log.Printf("[DEBUG] Deallocating Virtual Machine %q (resource group %q) to resize the attached Managed Disk", name, resGroup)
+	_, deallocateErr := vmClient.Deallocate(resGroup, name, make(chan struct{}))
+	if err := <-deallocateErr; err != nil {
+		return false, fmt.Errorf("[ERROR] Error deallocating Virtual Machine %q (resource group %q): %+v", name, resGroup, err)
+	}
+
+	// only Virtual Machines which were running are started again
+	return powerState == "running" || powerState == "starting", nil
+}
+
+func startArmVirtualMachineAfterDiskResize(meta interface{}, virtualMachineId string) error {
+	vmClient := meta.(*ArmClient).vmClient
+
+	id, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["virtualMachines"]
+
+	log.Printf("[DEBUG] Starting Virtual Machine %q (resource group %q) after resizing the attached Managed Disk", name, resGroup)
+	_, startErr := vmClient.Start(resGroup, name, make(chan struct{}))
+	if err := <-startErr; err != nil {
+		return fmt.Errorf("[ERROR] Error starting Virtual Machine %q (resource group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
 }
@@ -82,6 +82,26 @@ func TestAccAzureRMManagedDisk_copy(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMManagedDisk_copyFromSnapshot(t *testing.T) {
+	var d disk.Model
+	ri := acctest.RandInt()
+	config := testAccAzureRMManagedDisk_copyFromSnapshot(ri, testLocation())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists("azurerm_managed_disk.test", &d, true),
+					resource.TestCheckResourceAttrPair("azurerm_managed_disk.test", "source_resource_id", "azurerm_snapshot.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMManagedDisk_update(t *testing.T) {
 	var d disk.Model
 
@@ -369,3 +389,39 @@ resource "azurerm_managed_disk" "test" {
     }
 }`, rInt, location, rInt)
 }
+
+func testAccAzureRMManagedDisk_copyFromSnapshot(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_managed_disk" "source" {
+    name = "acctestd1-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_type = "Standard_LRS"
+    create_option = "Empty"
+    disk_size_gb = "1"
+}
+
+resource "azurerm_snapshot" "test" {
+    name = "acctestss-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    create_option = "Copy"
+    source_resource_id = "${azurerm_managed_disk.source.id}"
+}
+
+resource "azurerm_managed_disk" "test" {
+    name = "acctestd2-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_type = "Standard_LRS"
+    create_option = "Copy"
+    source_resource_id = "${azurerm_snapshot.test.id}"
+    disk_size_gb = "1"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -180,14 +180,6 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		properties.NetworkSecurityGroup = &network.SecurityGroup{
 			ID: &nsgId,
 		}
-
-		networkSecurityGroupName, err := parseNetworkSecurityGroupName(nsgId)
-		if err != nil {
-			return err
-		}
-
-		azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-		defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
 	}
 
 	dns, hasDns := d.GetOk("dns_servers")
@@ -213,16 +205,36 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		properties.DNSSettings = &ifaceDnsSettings
 	}
 
-	ipConfigs, subnetnToLock, vnnToLock, sgErr := expandAzureRmNetworkInterfaceIpConfigurations(d)
+	ipConfigs, idsToLock, sgErr := expandAzureRmNetworkInterfaceIpConfigurations(d)
 	if sgErr != nil {
 		return fmt.Errorf("Error Building list of Network Interface IP Configurations: %+v", sgErr)
 	}
 
-	azureRMLockMultipleByName(subnetnToLock, subnetResourceName)
-	defer azureRMUnlockMultipleByName(subnetnToLock, subnetResourceName)
+	if properties.NetworkSecurityGroup != nil {
+		idsToLock = append(idsToLock, *properties.NetworkSecurityGroup.ID)
+	}
 
-	azureRMLockMultipleByName(vnnToLock, virtualNetworkResourceName)
-	defer azureRMUnlockMultipleByName(vnnToLock, virtualNetworkResourceName)
+	networkInterfaceId := networkResourceID(meta.(*ArmClient).subscriptionId, resGroup, "networkInterfaces", name)
+	idsToLock = append(idsToLock, networkInterfaceId)
+
+	azureRMLockMultipleByID(idsToLock)
+	defer azureRMUnlockMultipleByID(idsToLock)
+
+	if !d.IsNewResource() {
+		// Application Gateway Backend Address Pools can only be associated via the
+		// `azurerm_network_interface_application_gateway_backend_address_pool_association` resource
+		existing, err := client.Get(resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		for i, config := range ipConfigs {
+			existingConfig := findNetworkInterfaceIPConfigurationByName(&existing, *config.Name)
+			if existingConfig != nil && existingConfig.InterfaceIPConfigurationPropertiesFormat != nil {
+				ipConfigs[i].InterfaceIPConfigurationPropertiesFormat.ApplicationGatewayBackendAddressPools = existingConfig.InterfaceIPConfigurationPropertiesFormat.ApplicationGatewayBackendAddressPools
+			}
+		}
+	}
 
 	if len(ipConfigs) > 0 {
 		properties.IPConfigurations = &ipConfigs
@@ -273,6 +285,16 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error making Read request on Azure Network Interface %s: %+v", name, err)
 	}
 
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	flattenAndSetNetworkInterface(d, resp)
+
+	return nil
+}
+
+// flattenAndSetNetworkInterface sets the properties of the Network Interface which are common
+// to both the resource and the data source
+func flattenAndSetNetworkInterface(d *schema.ResourceData, resp network.Interface) {
 	iface := *resp.InterfacePropertiesFormat
 
 	if iface.MacAddress != nil {
@@ -324,21 +346,17 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if iface.NetworkSecurityGroup != nil {
-		d.Set("network_security_group_id", resp.NetworkSecurityGroup.ID)
+		d.Set("network_security_group_id", iface.NetworkSecurityGroup.ID)
 	} else {
 		d.Set("network_security_group_id", "")
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("resource_group_name", resGroup)
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
 	d.Set("applied_dns_servers", appliedDNSServers)
 	d.Set("dns_servers", dnsServers)
 	d.Set("enable_ip_forwarding", resp.EnableIPForwarding)
 
 	flattenAndSetTags(d, resp.Tags)
-
-	return nil
 }
 
 func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -351,45 +369,25 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["networkInterfaces"]
 
+	idsToLock := []string{d.Id()}
 	if v, ok := d.GetOk("network_security_group_id"); ok {
-		networkSecurityGroupId := v.(string)
-		networkSecurityGroupName, err := parseNetworkSecurityGroupName(networkSecurityGroupId)
-		if err != nil {
-			return err
-		}
-
-		azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-		defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		idsToLock = append(idsToLock, v.(string))
 	}
 
 	configs := d.Get("ip_configuration").([]interface{})
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
-
 	for _, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
 
-		subnet_id := data["subnet_id"].(string)
-		subnetId, err := parseAzureResourceID(subnet_id)
+		subnetIdsToLock, err := networkInterfaceSubnetIDsToLock(data["subnet_id"].(string))
 		if err != nil {
 			return err
 		}
-		subnetName := subnetId.Path["subnets"]
-		if !sliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
-		}
 
-		virtualNetworkName := subnetId.Path["virtualNetworks"]
-		if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
-		}
+		idsToLock = append(idsToLock, subnetIdsToLock...)
 	}
 
-	azureRMLockMultipleByName(&subnetNamesToLock, subnetResourceName)
-	defer azureRMUnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
-
-	azureRMLockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
-	defer azureRMUnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+	azureRMLockMultipleByID(idsToLock)
+	defer azureRMUnlockMultipleByID(idsToLock)
 
 	_, deleteErr := client.Delete(resGroup, name, make(chan struct{}))
 	err = <-deleteErr
@@ -441,11 +439,10 @@ func flattenNetworkInterfaceIPConfigurations(ipConfigs *[]network.InterfaceIPCon
 	return result
 }
 
-func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]network.InterfaceIPConfiguration, *[]string, *[]string, error) {
+func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]network.InterfaceIPConfiguration, []string, error) {
 	configs := d.Get("ip_configuration").([]interface{})
 	ipConfigs := make([]network.InterfaceIPConfiguration, 0, len(configs))
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	idsToLock := make([]string, 0)
 
 	for _, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
@@ -461,21 +458,12 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 			PrivateIPAllocationMethod: allocationMethod,
 		}
 
-		subnetId, err := parseAzureResourceID(subnet_id)
+		subnetIdsToLock, err := networkInterfaceSubnetIDsToLock(subnet_id)
 		if err != nil {
-			return []network.InterfaceIPConfiguration{}, nil, nil, err
+			return []network.InterfaceIPConfiguration{}, nil, err
 		}
 
-		subnetName := subnetId.Path["subnets"]
-		virtualNetworkName := subnetId.Path["virtualNetworks"]
-
-		if !sliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
-		}
-
-		if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
-		}
+		idsToLock = append(idsToLock, subnetIdsToLock...)
 
 		if v := data["private_ip_address"].(string); v != "" {
 			properties.PrivateIPAddress = &v
@@ -542,11 +530,23 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 		}
 
 		if !hasPrimary {
-			return nil, nil, nil, fmt.Errorf("If multiple `ip_configurations` are specified - one must be designated as `primary`.")
+			return nil, nil, fmt.Errorf("If multiple `ip_configurations` are specified - one must be designated as `primary`.")
 		}
 	}
 
-	return ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
+	return ipConfigs, idsToLock, nil
+}
+
+// networkInterfaceSubnetIDsToLock returns the ID's of both the Subnet and it's
+// parent Virtual Network, both of which need to be locked when modifying the NIC
+func networkInterfaceSubnetIDsToLock(subnetId string) ([]string, error) {
+	id, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualNetworkId := networkResourceID(id.SubscriptionID, id.ResourceGroup, "virtualNetworks", id.Path["virtualNetworks"])
+	return []string{subnetId, virtualNetworkId}, nil
 }
 
 func sliceContainsValue(input []string, value string) bool {
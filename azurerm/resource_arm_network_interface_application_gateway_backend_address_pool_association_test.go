@@ -0,0 +1,250 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_application_gateway_backend_address_pool_association.test"
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_basic(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		networkInterfaceId := rs.Primary.Attributes["network_interface_id"]
+		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
+		backendAddressPoolId := rs.Primary.Attributes["backend_address_pool_id"]
+
+		config, exists, err := retrieveNetworkInterfaceIPConfiguration(networkInterfaceId, ipConfigurationName, testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: IP Configuration %q of Network Interface %q does not exist", ipConfigurationName, networkInterfaceId)
+		}
+
+		if pools := config.InterfaceIPConfigurationPropertiesFormat.ApplicationGatewayBackendAddressPools; pools != nil {
+			for _, pool := range *pools {
+				if strings.EqualFold(*pool.ID, backendAddressPoolId) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: Application Gateway Backend Address Pool %q is not associated with Network Interface %q", backendAddressPoolId, networkInterfaceId)
+	}
+}
+
+// there's no Application Gateway resource in this Provider, so it's provisioned using a Template Deployment
+func testAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "gateway" {
+  name                 = "gateway"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  parameters {
+    name       = "acctestag-%d"
+    subnetId   = "${azurerm_subnet.gateway.id}"
+    publicIpId = "${azurerm_public_ip.test.id}"
+  }
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "name": {
+      "type": "string"
+    },
+    "subnetId": {
+      "type": "string"
+    },
+    "publicIpId": {
+      "type": "string"
+    }
+  },
+  "variables": {
+    "gatewayId": "[resourceId('Microsoft.Network/applicationGateways', parameters('name'))]"
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Network/applicationGateways",
+      "apiVersion": "2017-09-01",
+      "name": "[parameters('name')]",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "sku": {
+          "name": "Standard_Small",
+          "tier": "Standard",
+          "capacity": 1
+        },
+        "gatewayIPConfigurations": [
+          {
+            "name": "gateway",
+            "properties": {
+              "subnet": {
+                "id": "[parameters('subnetId')]"
+              }
+            }
+          }
+        ],
+        "frontendIPConfigurations": [
+          {
+            "name": "frontend",
+            "properties": {
+              "publicIPAddress": {
+                "id": "[parameters('publicIpId')]"
+              }
+            }
+          }
+        ],
+        "frontendPorts": [
+          {
+            "name": "http",
+            "properties": {
+              "port": 80
+            }
+          }
+        ],
+        "backendAddressPools": [
+          {
+            "name": "backend"
+          }
+        ],
+        "backendHttpSettingsCollection": [
+          {
+            "name": "http",
+            "properties": {
+              "port": 80,
+              "protocol": "Http",
+              "cookieBasedAffinity": "Disabled"
+            }
+          }
+        ],
+        "httpListeners": [
+          {
+            "name": "http",
+            "properties": {
+              "frontendIPConfiguration": {
+                "id": "[concat(variables('gatewayId'), '/frontendIPConfigurations/frontend')]"
+              },
+              "frontendPort": {
+                "id": "[concat(variables('gatewayId'), '/frontendPorts/http')]"
+              },
+              "protocol": "Http"
+            }
+          }
+        ],
+        "requestRoutingRules": [
+          {
+            "name": "http",
+            "properties": {
+              "ruleType": "Basic",
+              "httpListener": {
+                "id": "[concat(variables('gatewayId'), '/httpListeners/http')]"
+              },
+              "backendAddressPool": {
+                "id": "[concat(variables('gatewayId'), '/backendAddressPools/backend')]"
+              },
+              "backendHttpSettings": {
+                "id": "[concat(variables('gatewayId'), '/backendHttpSettingsCollection/http')]"
+              }
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "outputs": {
+    "backendAddressPoolId": {
+      "type": "string",
+      "value": "[concat(variables('gatewayId'), '/backendAddressPools/backend')]"
+    }
+  }
+}
+DEPLOY
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_network_interface_application_gateway_backend_address_pool_association" "test" {
+  network_interface_id    = "${azurerm_network_interface.test.id}"
+  ip_configuration_name   = "testconfiguration1"
+  backend_address_pool_id = "${azurerm_template_deployment.test.outputs["backendAddressPoolId"]}"
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt)
+}
@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociation() *schema.Resource {
+	return networkInterfaceAssociation{
+		field:       "backend_address_pool_id",
+		displayName: "Backend Address Pool",
+		getIDs: func(props *network.InterfaceIPConfigurationPropertiesFormat) []string {
+			ids := make([]string, 0)
+			if props.LoadBalancerBackendAddressPools != nil {
+				for _, pool := range *props.LoadBalancerBackendAddressPools {
+					if pool.ID != nil {
+						ids = append(ids, *pool.ID)
+					}
+				}
+			}
+			return ids
+		},
+		setIDs: func(props *network.InterfaceIPConfigurationPropertiesFormat, ids []string) {
+			pools := make([]network.BackendAddressPool, 0, len(ids))
+			for i := range ids {
+				pools = append(pools, network.BackendAddressPool{
+					ID: &ids[i],
+				})
+			}
+			props.LoadBalancerBackendAddressPools = &pools
+		},
+	}.resource()
+}
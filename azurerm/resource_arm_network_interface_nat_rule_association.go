@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmNetworkInterfaceNatRuleAssociation() *schema.Resource {
+	return networkInterfaceAssociation{
+		field:       "nat_rule_id",
+		displayName: "NAT Rule",
+		getIDs: func(props *network.InterfaceIPConfigurationPropertiesFormat) []string {
+			ids := make([]string, 0)
+			if props.LoadBalancerInboundNatRules != nil {
+				for _, rule := range *props.LoadBalancerInboundNatRules {
+					if rule.ID != nil {
+						ids = append(ids, *rule.ID)
+					}
+				}
+			}
+			return ids
+		},
+		setIDs: func(props *network.InterfaceIPConfigurationPropertiesFormat, ids []string) {
+			rules := make([]network.InboundNatRule, 0, len(ids))
+			for i := range ids {
+				rules = append(rules, network.InboundNatRule{
+					ID: &ids[i],
+				})
+			}
+			props.LoadBalancerInboundNatRules = &rules
+		},
+	}.resource()
+}
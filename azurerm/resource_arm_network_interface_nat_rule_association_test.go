@@ -0,0 +1,135 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMNetworkInterfaceNatRuleAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_nat_rule_association.test"
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceNatRuleAssociation_basic(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceNatRuleAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceNatRuleAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		networkInterfaceId := rs.Primary.Attributes["network_interface_id"]
+		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
+		natRuleId := rs.Primary.Attributes["nat_rule_id"]
+
+		config, exists, err := retrieveNetworkInterfaceIPConfiguration(networkInterfaceId, ipConfigurationName, testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: IP Configuration %q of Network Interface %q does not exist", ipConfigurationName, networkInterfaceId)
+		}
+
+		if rules := config.InterfaceIPConfigurationPropertiesFormat.LoadBalancerInboundNatRules; rules != nil {
+			for _, rule := range *rules {
+				if strings.EqualFold(*rule.ID, natRuleId) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: NAT Rule %q is not associated with Network Interface %q", natRuleId, networkInterfaceId)
+	}
+}
+
+func testAccAzureRMNetworkInterfaceNatRuleAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "primary"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_nat_rule" "test" {
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  name                           = "RDPAccess"
+  protocol                       = "Tcp"
+  frontend_port                  = 3389
+  backend_port                   = 3389
+  frontend_ip_configuration_name = "primary"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_network_interface_nat_rule_association" "test" {
+  network_interface_id  = "${azurerm_network_interface.test.id}"
+  ip_configuration_name = "testconfiguration1"
+  nat_rule_id           = "${azurerm_lb_nat_rule.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
@@ -13,8 +13,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var networkSecurityGroupResourceName = "azurerm_network_security_group"
-
 func resourceArmNetworkSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmNetworkSecurityGroupCreate,
@@ -139,8 +137,23 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error Building list of Network Security Group Rules: %s", sgErr)
 	}
 
-	azureRMLockByName(name, networkSecurityGroupResourceName)
-	defer azureRMUnlockByName(name, networkSecurityGroupResourceName)
+	nsgId := networkResourceID(client.subscriptionId, resGroup, "networkSecurityGroups", name)
+	azureRMLockByID(nsgId)
+	defer azureRMUnlockByID(nsgId)
+
+	if inlineChildrenAreAuthoritative(d, "security_rule") {
+		logInlineChildrenToBeRemoved(d, "security_rule", "azurerm_network_security_rule")
+	} else {
+		// the rules aren't managed inline, so retain those which exist (e.g. from `azurerm_network_security_rule`)
+		existing, err := secClient.Get(resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Network Security Group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+			sgRules = *props.SecurityRules
+		}
+	}
 
 	sg := network.SecurityGroup{
 		Name:     &name,
@@ -201,18 +214,25 @@ func resourceArmNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error making Read request on Azure Network Security Group %s: %s", name, err)
 	}
 
-	if resp.SecurityGroupPropertiesFormat.SecurityRules != nil {
-		d.Set("security_rule", flattenNetworkSecurityRules(resp.SecurityGroupPropertiesFormat.SecurityRules))
-	}
-
 	d.Set("resource_group_name", resGroup)
 	d.Set("name", resp.Name)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetNetworkSecurityGroup(d, resp)
 
 	return nil
 }
 
+// flattenAndSetNetworkSecurityGroup sets the properties of the Network Security Group which
+// are common to both the resource and the data source
+func flattenAndSetNetworkSecurityGroup(d *schema.ResourceData, resp network.SecurityGroup) {
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		d.Set("security_rule", flattenNetworkSecurityRules(props.SecurityRules))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+}
+
 func resourceArmNetworkSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	secGroupClient := meta.(*ArmClient).secGroupClient
 
@@ -108,6 +108,34 @@ func TestAccAzureRMNetworkSecurityGroup_addingExtraRules(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMNetworkSecurityGroup_standaloneRules(t *testing.T) {
+	rInt := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkSecurityGroup_standaloneRules(rInt, location, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupExists("azurerm_network_security_group.test"),
+					testCheckAzureRMNetworkSecurityRuleExists("azurerm_network_security_rule.test"),
+				),
+			},
+			{
+				// updating the NSG shouldn't remove the standalone rule
+				Config: testAccAzureRMNetworkSecurityGroup_standaloneRules(rInt, location, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupExists("azurerm_network_security_group.test"),
+					testCheckAzureRMNetworkSecurityRuleExists("azurerm_network_security_rule.test"),
+					resource.TestCheckResourceAttr("azurerm_network_security_group.test", "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMNetworkSecurityGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -318,3 +346,36 @@ resource "azurerm_network_security_group" "test" {
 
 `, rInt, location)
 }
+
+func testAccAzureRMNetworkSecurityGroup_standaloneRules(rInt int, location string, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "%s"
+  }
+}
+
+resource "azurerm_network_security_rule" "test" {
+  name                        = "test123"
+  priority                    = 100
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  network_security_group_name = "${azurerm_network_security_group.test.name}"
+}
+`, rInt, location, environment)
+}
@@ -121,8 +121,9 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 	direction := d.Get("direction").(string)
 	protocol := d.Get("protocol").(string)
 
-	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
-	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
+	nsgId := networkResourceID(client.subscriptionId, resGroup, "networkSecurityGroups", nsgName)
+	azureRMLockByID(nsgId)
+	defer azureRMUnlockByID(nsgId)
 
 	properties := network.SecurityRulePropertiesFormat{
 		SourcePortRange:          &source_port_range,
@@ -211,8 +212,9 @@ func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface
 	nsgName := id.Path["networkSecurityGroups"]
 	sgRuleName := id.Path["securityRules"]
 
-	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
-	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
+	nsgId := networkResourceID(id.SubscriptionID, resGroup, "networkSecurityGroups", nsgName)
+	azureRMLockByID(nsgId)
+	defer azureRMUnlockByID(nsgId)
 
 	_, error := secRuleClient.Delete(resGroup, nsgName, sgRuleName, make(chan struct{}))
 	err = <-error
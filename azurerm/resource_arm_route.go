@@ -72,8 +72,9 @@ func resourceArmRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	addressPrefix := d.Get("address_prefix").(string)
 	nextHopType := d.Get("next_hop_type").(string)
 
-	azureRMLockByName(rtName, routeTableResourceName)
-	defer azureRMUnlockByName(rtName, routeTableResourceName)
+	routeTableId := networkResourceID(client.subscriptionId, resGroup, "routeTables", rtName)
+	azureRMLockByID(routeTableId)
+	defer azureRMUnlockByID(routeTableId)
 
 	properties := network.RoutePropertiesFormat{
 		AddressPrefix: &addressPrefix,
@@ -153,8 +154,9 @@ func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	rtName := id.Path["routeTables"]
 	routeName := id.Path["routes"]
 
-	azureRMLockByName(rtName, routeTableResourceName)
-	defer azureRMUnlockByName(rtName, routeTableResourceName)
+	routeTableId := networkResourceID(id.SubscriptionID, resGroup, "routeTables", rtName)
+	azureRMLockByID(routeTableId)
+	defer azureRMUnlockByID(routeTableId)
 
 	_, error := routesClient.Delete(resGroup, rtName, routeName, make(chan struct{}))
 	err = <-error
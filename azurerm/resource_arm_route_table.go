@@ -12,8 +12,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var routeTableResourceName = "azurerm_route_table"
-
 func resourceArmRouteTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmRouteTableCreate,
@@ -100,15 +98,31 @@ func resourceArmRouteTableCreate(d *schema.ResourceData, meta interface{}) error
 		Tags:     expandTags(tags),
 	}
 
-	if _, ok := d.GetOk("route"); ok {
+	routeTableId := networkResourceID(client.subscriptionId, resGroup, "routeTables", name)
+	azureRMLockByID(routeTableId)
+	defer azureRMUnlockByID(routeTableId)
+
+	if inlineChildrenAreAuthoritative(d, "route") {
+		logInlineChildrenToBeRemoved(d, "route", "azurerm_route")
+
 		routes, routeErr := expandAzureRmRouteTableRoutes(d)
 		if routeErr != nil {
 			return fmt.Errorf("Error Building list of Route Table Routes: %s", routeErr)
 		}
 
-		if len(routes) > 0 {
+		routeSet.RouteTablePropertiesFormat = &network.RouteTablePropertiesFormat{
+			Routes: &routes,
+		}
+	} else {
+		// the routes aren't managed inline, so retain those which exist (e.g. from `azurerm_route`)
+		existing, err := routeTablesClient.Get(resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Route Table %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.RouteTablePropertiesFormat; props != nil && props.Routes != nil {
 			routeSet.RouteTablePropertiesFormat = &network.RouteTablePropertiesFormat{
-				Routes: &routes,
+				Routes: props.Routes,
 			}
 		}
 	}
@@ -153,24 +167,31 @@ func resourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", name)
 	d.Set("resource_group_name", resGroup)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	flattenAndSetRouteTable(d, resp)
 
-	if resp.RouteTablePropertiesFormat.Routes != nil {
-		d.Set("route", schema.NewSet(resourceArmRouteTableRouteHash, flattenAzureRmRouteTableRoutes(resp.RouteTablePropertiesFormat.Routes)))
-	}
+	return nil
+}
+
+// flattenAndSetRouteTable sets the properties of the Route Table which are common to both
+// the resource and the data source
+func flattenAndSetRouteTable(d *schema.ResourceData, resp network.RouteTable) {
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
 
 	subnets := []string{}
-	if resp.RouteTablePropertiesFormat.Subnets != nil {
-		for _, subnet := range *resp.RouteTablePropertiesFormat.Subnets {
-			id := subnet.ID
-			subnets = append(subnets, *id)
+	if props := resp.RouteTablePropertiesFormat; props != nil {
+		if props.Routes != nil {
+			d.Set("route", schema.NewSet(resourceArmRouteTableRouteHash, flattenAzureRmRouteTableRoutes(props.Routes)))
+		}
+
+		if props.Subnets != nil {
+			for _, subnet := range *props.Subnets {
+				subnets = append(subnets, *subnet.ID)
+			}
 		}
 	}
 	d.Set("subnets", subnets)
 
 	flattenAndSetTags(d, resp.Tags)
-
-	return nil
 }
 
 func resourceArmRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
@@ -168,6 +168,34 @@ func TestAccAzureRMRouteTable_multipleRoutes(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRouteTable_standaloneRoutes(t *testing.T) {
+	rInt := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRouteTable_standaloneRoutes(rInt, location, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteTableExists("azurerm_route_table.test"),
+					testCheckAzureRMRouteExists("azurerm_route.test"),
+				),
+			},
+			{
+				// updating the Route Table shouldn't remove the standalone route
+				Config: testAccAzureRMRouteTable_standaloneRoutes(rInt, location, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteTableExists("azurerm_route_table.test"),
+					testCheckAzureRMRouteExists("azurerm_route.test"),
+					resource.TestCheckResourceAttr("azurerm_route_table.test", "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMRouteTableExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -346,3 +374,30 @@ resource "azurerm_route_table" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMRouteTable_standaloneRoutes(rInt int, location string, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "%s"
+  }
+}
+
+resource "azurerm_route" "test" {
+  name                = "acctestroute%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  route_table_name    = "${azurerm_route_table.test.name}"
+  address_prefix      = "10.1.0.0/16"
+  next_hop_type       = "vnetlocal"
+}
+`, rInt, location, rInt, environment, rInt)
+}
@@ -0,0 +1,796 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/scheduler"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSchedulerJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSchedulerJobCreateUpdate,
+		Read:   resourceArmSchedulerJobRead,
+		Update: resourceArmSchedulerJobCreateUpdate,
+		Delete: resourceArmSchedulerJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"job_collection_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"action_web": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"action_storage_queue"},
+				Elem:          resourceArmSchedulerJobActionWebSchema(),
+			},
+
+			"action_storage_queue": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"action_web"},
+				Elem:          resourceArmSchedulerJobActionStorageQueueSchema(),
+			},
+
+			"error_action_web": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"error_action_storage_queue"},
+				Elem:          resourceArmSchedulerJobActionWebSchema(),
+			},
+
+			"error_action_storage_queue": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"error_action_web"},
+				Elem:          resourceArmSchedulerJobActionStorageQueueSchema(),
+			},
+
+			"retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "00:00:30",
+						},
+
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntBetween(1, 20),
+						},
+					},
+				},
+			},
+
+			"recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scheduler.Minute),
+								string(scheduler.Hour),
+								string(scheduler.Day),
+								string(scheduler.Week),
+								string(scheduler.Month),
+							}, true),
+						},
+
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"end_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateRFC3339Date,
+							DiffSuppressFunc: rfc3339TimeDiffSuppressFunc,
+						},
+
+						"minutes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 59),
+							},
+						},
+
+						"hours": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 23),
+							},
+						},
+
+						"week_days": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							ConflictsWith: []string{"recurrence.0.month_days", "recurrence.0.monthly_occurrences"},
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(scheduler.Monday),
+									string(scheduler.Tuesday),
+									string(scheduler.Wednesday),
+									string(scheduler.Thursday),
+									string(scheduler.Friday),
+									string(scheduler.Saturday),
+									string(scheduler.Sunday),
+								}, false),
+							},
+						},
+
+						"month_days": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							ConflictsWith: []string{"recurrence.0.week_days", "recurrence.0.monthly_occurrences"},
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validateSchedulerJobRecurrenceMonthDay,
+							},
+						},
+
+						"monthly_occurrences": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							ConflictsWith: []string{"recurrence.0.week_days", "recurrence.0.month_days"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(scheduler.JobScheduleDayMonday),
+											string(scheduler.JobScheduleDayTuesday),
+											string(scheduler.JobScheduleDayWednesday),
+											string(scheduler.JobScheduleDayThursday),
+											string(scheduler.JobScheduleDayFriday),
+											string(scheduler.JobScheduleDaySaturday),
+											string(scheduler.JobScheduleDaySunday),
+										}, false),
+									},
+
+									"occurrence": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateSchedulerJobRecurrenceMonthlyOccurrence,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: rfc3339TimeDiffSuppressFunc,
+			},
+
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scheduler.JobStateEnabled),
+					string(scheduler.JobStateDisabled),
+					string(scheduler.JobStateCompleted),
+					string(scheduler.JobStateFaulted),
+				}, true),
+			},
+		},
+	}
+}
+
+func resourceArmSchedulerJobActionWebSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSchedulerJobActionWebURL,
+			},
+
+			"method": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Get",
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Get", "Put", "Post", "Delete",
+				}, true),
+			},
+
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"headers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmSchedulerJobActionStorageQueueSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"storage_queue_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"sas_token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).jobsClient
+
+	log.Printf("[INFO] preparing arguments for AzureRM Scheduler Job creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	jobCollection := d.Get("job_collection_name").(string)
+
+	action, err := expandAzureArmSchedulerJobAction(d)
+	if err != nil {
+		return err
+	}
+
+	properties := scheduler.JobProperties{
+		Action:     action,
+		Recurrence: expandAzureArmSchedulerJobRecurrence(d),
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		startTime, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `start_time` %q: %+v", v.(string), err)
+		}
+		properties.StartTime = &date.Time{Time: startTime}
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		properties.State = scheduler.JobState(v.(string))
+	}
+
+	job := scheduler.JobDefinition{
+		Properties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(resGroup, jobCollection, name, job); err != nil {
+		return fmt.Errorf("Error creating/updating Scheduler Job %q (Job Collection %q / Resource Group %q): %+v", name, jobCollection, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, jobCollection, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Scheduler Job %q (Job Collection %q / Resource Group %q): %+v", name, jobCollection, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Scheduler Job %q (Job Collection %q / Resource Group %q) ID", name, jobCollection, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSchedulerJobRead(d, meta)
+}
+
+func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).jobsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	jobCollection := id.Path["jobCollections"]
+	name := id.Path["jobs"]
+
+	resp, err := client.Get(resGroup, jobCollection, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Scheduler Job %q (Job Collection %q / Resource Group %q) was not found - removing from state", name, jobCollection, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Scheduler Job %q (Job Collection %q / Resource Group %q): %+v", name, jobCollection, resGroup, err)
+	}
+
+	// the API returns the name as `{jobCollectionName}/{jobName}`
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("job_collection_name", jobCollection)
+
+	props := resp.Properties
+	if props == nil {
+		return nil
+	}
+
+	if props.StartTime != nil {
+		d.Set("start_time", props.StartTime.Format(time.RFC3339))
+	}
+	d.Set("state", string(props.State))
+
+	if err := d.Set("recurrence", flattenAzureArmSchedulerJobRecurrence(props.Recurrence)); err != nil {
+		return fmt.Errorf("Error flattening `recurrence`: %+v", err)
+	}
+
+	if action := props.Action; action != nil {
+		if err := d.Set("action_web", flattenAzureArmSchedulerJobActionWeb(action.Request)); err != nil {
+			return fmt.Errorf("Error flattening `action_web`: %+v", err)
+		}
+
+		if err := d.Set("action_storage_queue", flattenAzureArmSchedulerJobActionStorageQueue(action.QueueMessage, d.Get("action_storage_queue").([]interface{}))); err != nil {
+			return fmt.Errorf("Error flattening `action_storage_queue`: %+v", err)
+		}
+
+		if err := d.Set("retry", flattenAzureArmSchedulerJobRetry(action.RetryPolicy)); err != nil {
+			return fmt.Errorf("Error flattening `retry`: %+v", err)
+		}
+
+		errorWeb := make([]interface{}, 0)
+		errorStorageQueue := make([]interface{}, 0)
+		if errorAction := action.ErrorAction; errorAction != nil {
+			errorWeb = flattenAzureArmSchedulerJobActionWeb(errorAction.Request)
+			errorStorageQueue = flattenAzureArmSchedulerJobActionStorageQueue(errorAction.QueueMessage, d.Get("error_action_storage_queue").([]interface{}))
+		}
+
+		if err := d.Set("error_action_web", errorWeb); err != nil {
+			return fmt.Errorf("Error flattening `error_action_web`: %+v", err)
+		}
+
+		if err := d.Set("error_action_storage_queue", errorStorageQueue); err != nil {
+			return fmt.Errorf("Error flattening `error_action_storage_queue`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSchedulerJobDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).jobsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	jobCollection := id.Path["jobCollections"]
+	name := id.Path["jobs"]
+
+	log.Printf("[DEBUG] Deleting Scheduler Job %q (Job Collection %q / Resource Group %q)", name, jobCollection, resGroup)
+
+	resp, err := client.Delete(resGroup, jobCollection, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Scheduler Job %q (Job Collection %q / Resource Group %q): %+v", name, jobCollection, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureArmSchedulerJobAction(d *schema.ResourceData) (*scheduler.JobAction, error) {
+	action := scheduler.JobAction{}
+
+	if v, ok := d.GetOk("action_web"); ok {
+		action.Type, action.Request = expandAzureArmSchedulerJobActionWeb(v.([]interface{}))
+	} else if v, ok := d.GetOk("action_storage_queue"); ok {
+		action.Type = scheduler.StorageQueue
+		action.QueueMessage = expandAzureArmSchedulerJobActionStorageQueue(v.([]interface{}))
+	} else {
+		return nil, fmt.Errorf("One of `action_web` or `action_storage_queue` must be specified")
+	}
+
+	if v, ok := d.GetOk("error_action_web"); ok {
+		errorAction := scheduler.JobErrorAction{}
+		errorAction.Type, errorAction.Request = expandAzureArmSchedulerJobActionWeb(v.([]interface{}))
+		action.ErrorAction = &errorAction
+	} else if v, ok := d.GetOk("error_action_storage_queue"); ok {
+		action.ErrorAction = &scheduler.JobErrorAction{
+			Type:         scheduler.StorageQueue,
+			QueueMessage: expandAzureArmSchedulerJobActionStorageQueue(v.([]interface{})),
+		}
+	}
+
+	retry := scheduler.RetryPolicy{
+		RetryType: scheduler.None,
+	}
+	if v, ok := d.GetOk("retry"); ok {
+		retries := v.([]interface{})
+		if len(retries) > 0 && retries[0] != nil {
+			config := retries[0].(map[string]interface{})
+			retry.RetryType = scheduler.Fixed
+			retry.RetryInterval = utils.String(config["interval"].(string))
+			retry.RetryCount = utils.Int32(int32(config["count"].(int)))
+		}
+	}
+	action.RetryPolicy = &retry
+
+	return &action, nil
+}
+
+func expandAzureArmSchedulerJobActionWeb(input []interface{}) (scheduler.JobActionType, *scheduler.HTTPRequest) {
+	config := input[0].(map[string]interface{})
+
+	uri := config["url"].(string)
+	request := scheduler.HTTPRequest{
+		URI:    utils.String(uri),
+		Method: utils.String(config["method"].(string)),
+	}
+
+	if v := config["body"].(string); v != "" {
+		request.Body = utils.String(v)
+	}
+
+	if v, ok := config["headers"].(map[string]interface{}); ok && len(v) > 0 {
+		headers := make(map[string]*string, len(v))
+		for k, value := range v {
+			headers[k] = utils.String(value.(string))
+		}
+		request.Headers = &headers
+	}
+
+	actionType := scheduler.HTTP
+	if strings.HasPrefix(strings.ToLower(uri), "https://") {
+		actionType = scheduler.HTTPS
+	}
+
+	return actionType, &request
+}
+
+func expandAzureArmSchedulerJobActionStorageQueue(input []interface{}) *scheduler.StorageQueueMessage {
+	config := input[0].(map[string]interface{})
+
+	message := scheduler.StorageQueueMessage{
+		StorageAccount: utils.String(config["storage_account_name"].(string)),
+		QueueName:      utils.String(config["storage_queue_name"].(string)),
+		SasToken:       utils.String(config["sas_token"].(string)),
+	}
+
+	if v := config["message"].(string); v != "" {
+		message.Message = utils.String(v)
+	}
+
+	return &message
+}
+
+func expandAzureArmSchedulerJobRecurrence(d *schema.ResourceData) *scheduler.JobRecurrence {
+	recurrences := d.Get("recurrence").([]interface{})
+	if len(recurrences) == 0 || recurrences[0] == nil {
+		return nil
+	}
+
+	config := recurrences[0].(map[string]interface{})
+
+	recurrence := scheduler.JobRecurrence{
+		Frequency: scheduler.RecurrenceFrequency(config["frequency"].(string)),
+		Interval:  utils.Int32(int32(config["interval"].(int))),
+	}
+
+	if v, ok := config["count"].(int); ok && v > 0 {
+		recurrence.Count = utils.Int32(int32(v))
+	}
+
+	if v := config["end_time"].(string); v != "" {
+		// this has been validated by validateRFC3339Date
+		endTime, _ := time.Parse(time.RFC3339, v)
+		recurrence.EndTime = &date.Time{Time: endTime}
+	}
+
+	schedule := scheduler.JobRecurrenceSchedule{}
+	hasSchedule := false
+
+	if v := config["minutes"].(*schema.Set).List(); len(v) > 0 {
+		schedule.Minutes = expandAzureArmSchedulerJobInt32List(v)
+		hasSchedule = true
+	}
+
+	if v := config["hours"].(*schema.Set).List(); len(v) > 0 {
+		schedule.Hours = expandAzureArmSchedulerJobInt32List(v)
+		hasSchedule = true
+	}
+
+	if v := config["week_days"].(*schema.Set).List(); len(v) > 0 {
+		weekDays := make([]scheduler.DayOfWeek, 0, len(v))
+		for _, day := range v {
+			weekDays = append(weekDays, scheduler.DayOfWeek(day.(string)))
+		}
+		schedule.WeekDays = &weekDays
+		hasSchedule = true
+	}
+
+	if v := config["month_days"].(*schema.Set).List(); len(v) > 0 {
+		schedule.MonthDays = expandAzureArmSchedulerJobInt32List(v)
+		hasSchedule = true
+	}
+
+	if v := config["monthly_occurrences"].(*schema.Set).List(); len(v) > 0 {
+		occurrences := make([]scheduler.JobRecurrenceScheduleMonthlyOccurrence, 0, len(v))
+		for _, raw := range v {
+			occurrence := raw.(map[string]interface{})
+			occurrences = append(occurrences, scheduler.JobRecurrenceScheduleMonthlyOccurrence{
+				Day:        scheduler.JobScheduleDay(occurrence["day"].(string)),
+				Occurrence: utils.Int32(int32(occurrence["occurrence"].(int))),
+			})
+		}
+		schedule.MonthlyOccurrences = &occurrences
+		hasSchedule = true
+	}
+
+	if hasSchedule {
+		recurrence.Schedule = &schedule
+	}
+
+	return &recurrence
+}
+
+func expandAzureArmSchedulerJobInt32List(input []interface{}) *[]int32 {
+	output := make([]int32, 0, len(input))
+	for _, v := range input {
+		output = append(output, int32(v.(int)))
+	}
+	return &output
+}
+
+func flattenAzureArmSchedulerJobActionWeb(input *scheduler.HTTPRequest) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if input.URI != nil {
+		result["url"] = *input.URI
+	}
+
+	if input.Method != nil {
+		result["method"] = *input.Method
+	}
+
+	if input.Body != nil {
+		result["body"] = *input.Body
+	}
+
+	headers := make(map[string]interface{}, 0)
+	if input.Headers != nil {
+		for k, v := range *input.Headers {
+			if v != nil {
+				headers[k] = *v
+			}
+		}
+	}
+	result["headers"] = headers
+
+	return append(results, result)
+}
+
+func flattenAzureArmSchedulerJobActionStorageQueue(input *scheduler.StorageQueueMessage, existing []interface{}) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if input.StorageAccount != nil {
+		result["storage_account_name"] = *input.StorageAccount
+	}
+
+	if input.QueueName != nil {
+		result["storage_queue_name"] = *input.QueueName
+	}
+
+	if input.Message != nil {
+		result["message"] = *input.Message
+	}
+
+	// the SAS Token isn't returned from the API, so the value from the config is used
+	if input.SasToken != nil {
+		result["sas_token"] = *input.SasToken
+	} else if len(existing) > 0 && existing[0] != nil {
+		result["sas_token"] = existing[0].(map[string]interface{})["sas_token"]
+	}
+
+	return append(results, result)
+}
+
+func flattenAzureArmSchedulerJobRetry(input *scheduler.RetryPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.RetryType == scheduler.None {
+		return results
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if input.RetryInterval != nil {
+		result["interval"] = *input.RetryInterval
+	}
+
+	if input.RetryCount != nil {
+		result["count"] = int(*input.RetryCount)
+	}
+
+	return append(results, result)
+}
+
+func flattenAzureArmSchedulerJobRecurrence(input *scheduler.JobRecurrence) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	result := map[string]interface{}{
+		"frequency": string(input.Frequency),
+	}
+
+	if input.Interval != nil {
+		result["interval"] = int(*input.Interval)
+	}
+
+	if input.Count != nil {
+		result["count"] = int(*input.Count)
+	}
+
+	if input.EndTime != nil {
+		result["end_time"] = input.EndTime.Format(time.RFC3339)
+	}
+
+	if schedule := input.Schedule; schedule != nil {
+		if schedule.Minutes != nil {
+			result["minutes"] = flattenAzureArmSchedulerJobInt32List(*schedule.Minutes)
+		}
+
+		if schedule.Hours != nil {
+			result["hours"] = flattenAzureArmSchedulerJobInt32List(*schedule.Hours)
+		}
+
+		if schedule.WeekDays != nil {
+			weekDays := make([]interface{}, 0)
+			for _, day := range *schedule.WeekDays {
+				weekDays = append(weekDays, string(day))
+			}
+			result["week_days"] = weekDays
+		}
+
+		if schedule.MonthDays != nil {
+			result["month_days"] = flattenAzureArmSchedulerJobInt32List(*schedule.MonthDays)
+		}
+
+		if schedule.MonthlyOccurrences != nil {
+			occurrences := make([]interface{}, 0)
+			for _, occurrence := range *schedule.MonthlyOccurrences {
+				output := map[string]interface{}{
+					"day": string(occurrence.Day),
+				}
+				if occurrence.Occurrence != nil {
+					output["occurrence"] = int(*occurrence.Occurrence)
+				}
+				occurrences = append(occurrences, output)
+			}
+			result["monthly_occurrences"] = occurrences
+		}
+	}
+
+	return append(results, result)
+}
+
+func flattenAzureArmSchedulerJobInt32List(input []int32) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range input {
+		output = append(output, int(v))
+	}
+	return output
+}
+
+func validateSchedulerJobActionWebURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	uri, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is an invalid URL: %+v", k, err))
+		return
+	}
+
+	if uri.Scheme != "http" && uri.Scheme != "https" {
+		errors = append(errors, fmt.Errorf("%q must be a HTTP or HTTPS URL, got %q", k, value))
+	}
+
+	return
+}
+
+func validateSchedulerJobRecurrenceMonthDay(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	if value == 0 || value < -31 || value > 31 {
+		errors = append(errors, fmt.Errorf("%q must be between -31 and 31 (excluding 0), got %d", k, value))
+	}
+
+	return
+}
+
+func validateSchedulerJobRecurrenceMonthlyOccurrence(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	if value == 0 || value < -5 || value > 5 {
+		errors = append(errors, fmt.Errorf("%q must be between -5 and 5 (excluding 0), got %d", k, value))
+	}
+
+	return
+}
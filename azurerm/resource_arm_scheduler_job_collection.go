@@ -0,0 +1,249 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/scheduler"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSchedulerJobCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSchedulerJobCollectionCreateUpdate,
+		Read:   resourceArmSchedulerJobCollectionRead,
+		Update: resourceArmSchedulerJobCollectionCreateUpdate,
+		Delete: resourceArmSchedulerJobCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scheduler.Free),
+					string(scheduler.Standard),
+					string(scheduler.P10Premium),
+					string(scheduler.P20Premium),
+				}, true),
+			},
+
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(scheduler.Enabled),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scheduler.Enabled),
+					string(scheduler.Suspended),
+					string(scheduler.Disabled),
+				}, true),
+			},
+
+			"quota": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_job_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"max_recurrence_frequency": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scheduler.Minute),
+								string(scheduler.Hour),
+								string(scheduler.Day),
+								string(scheduler.Week),
+								string(scheduler.Month),
+							}, true),
+						},
+
+						"max_recurrence_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).jobsCollectionsClient
+
+	log.Printf("[INFO] preparing arguments for AzureRM Scheduler Job Collection creation.")
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	collection := scheduler.JobCollectionDefinition{
+		Location: utils.String(location),
+		Tags:     expandTags(tags),
+		Properties: &scheduler.JobCollectionProperties{
+			Sku: &scheduler.Sku{
+				Name: scheduler.SkuDefinition(d.Get("sku").(string)),
+			},
+			State: scheduler.JobCollectionState(d.Get("state").(string)),
+			Quota: expandAzureArmSchedulerJobCollectionQuota(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(resGroup, name, collection); err != nil {
+		return fmt.Errorf("Error creating/updating Scheduler Job Collection %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Scheduler Job Collection %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Scheduler Job Collection %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSchedulerJobCollectionRead(d, meta)
+}
+
+func resourceArmSchedulerJobCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).jobsCollectionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["jobCollections"]
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Scheduler Job Collection %q (Resource Group %q) was not found - removing from state", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Scheduler Job Collection %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.Properties; props != nil {
+		if sku := props.Sku; sku != nil {
+			d.Set("sku", string(sku.Name))
+		}
+		d.Set("state", string(props.State))
+
+		if err := d.Set("quota", flattenAzureArmSchedulerJobCollectionQuota(props.Quota)); err != nil {
+			return fmt.Errorf("Error flattening `quota`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSchedulerJobCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).jobsCollectionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["jobCollections"]
+
+	log.Printf("[DEBUG] Deleting Scheduler Job Collection %q (Resource Group %q)", name, resGroup)
+
+	deleteResp, deleteErr := client.Delete(resGroup, name, make(chan struct{}))
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Scheduler Job Collection %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureArmSchedulerJobCollectionQuota(d *schema.ResourceData) *scheduler.JobCollectionQuota {
+	quotas := d.Get("quota").([]interface{})
+	if len(quotas) == 0 || quotas[0] == nil {
+		return nil
+	}
+
+	config := quotas[0].(map[string]interface{})
+
+	quota := scheduler.JobCollectionQuota{
+		MaxRecurrence: &scheduler.JobMaxRecurrence{
+			Frequency: scheduler.RecurrenceFrequency(config["max_recurrence_frequency"].(string)),
+		},
+	}
+
+	if v, ok := config["max_job_count"].(int); ok && v > 0 {
+		quota.MaxJobCount = utils.Int32(int32(v))
+	}
+
+	if v, ok := config["max_recurrence_interval"].(int); ok && v > 0 {
+		quota.MaxRecurrence.Interval = utils.Int32(int32(v))
+	}
+
+	return &quota
+}
+
+func flattenAzureArmSchedulerJobCollectionQuota(input *scheduler.JobCollectionQuota) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if input.MaxJobCount != nil {
+		result["max_job_count"] = int(*input.MaxJobCount)
+	}
+
+	if recurrence := input.MaxRecurrence; recurrence != nil {
+		result["max_recurrence_frequency"] = string(recurrence.Frequency)
+		if recurrence.Interval != nil {
+			result["max_recurrence_interval"] = int(*recurrence.Interval)
+		}
+	}
+
+	return append(results, result)
+}
@@ -0,0 +1,467 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSchedulerJob_web(t *testing.T) {
+	resourceName := "azurerm_scheduler_job.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSchedulerJob_web(ri, testLocation(), "http://example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_web.0.url", "http://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "action_web.0.method", "Get"),
+					resource.TestCheckResourceAttr(resourceName, "retry.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSchedulerJob_webUpdate(t *testing.T) {
+	resourceName := "azurerm_scheduler_job.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMSchedulerJob_web(ri, location, "http://example.com")
+	updatedConfig := testAccAzureRMSchedulerJob_webComplete(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobExists(resourceName),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_web.0.url", "https://example.com/endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "action_web.0.method", "Put"),
+					resource.TestCheckResourceAttr(resourceName, "action_web.0.body", "{\"hello\": \"world\"}"),
+					resource.TestCheckResourceAttr(resourceName, "action_web.0.headers.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "error_action_web.0.url", "https://example.com/error"),
+					resource.TestCheckResourceAttr(resourceName, "retry.0.interval", "00:05:00"),
+					resource.TestCheckResourceAttr(resourceName, "retry.0.count", "10"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSchedulerJob_storageQueue(t *testing.T) {
+	resourceName := "azurerm_scheduler_job.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMSchedulerJob_storageQueue(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_storage_queue.0.message", "storage message"),
+					resource.TestCheckResourceAttr(resourceName, "error_action_storage_queue.0.message", "storage error message"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSchedulerJob_recurrenceWeekly(t *testing.T) {
+	resourceName := "azurerm_scheduler_job.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSchedulerJob_recurrenceWeekly(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2030-07-07T07:07:07-07:00"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.frequency", "Week"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.count", "10"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.week_days.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.hours.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.minutes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSchedulerJob_recurrenceMonthly(t *testing.T) {
+	resourceName := "azurerm_scheduler_job.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSchedulerJob_recurrenceMonthly(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.frequency", "Month"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.end_time", "2031-07-17T07:07:07Z"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_occurrences.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSchedulerJobDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).jobsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_scheduler_job" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobCollection := rs.Primary.Attributes["job_collection_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, jobCollection, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Scheduler Job still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMSchedulerJobExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		jobName := rs.Primary.Attributes["name"]
+		jobCollection := rs.Primary.Attributes["job_collection_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Scheduler Job: %s", jobName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).jobsClient
+		resp, err := client.Get(resourceGroup, jobCollection, jobName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Scheduler Job %q (Job Collection %q / Resource Group %q) does not exist", jobName, jobCollection, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on jobsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func TestValidateSchedulerJobActionWebURL(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "http://example.com",
+			ErrCount: 0,
+		},
+		{
+			Value:    "https://example.com/path?query=value",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ftp://example.com",
+			ErrCount: 1,
+		},
+		{
+			Value:    "example.com",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateSchedulerJobActionWebURL(tc.Value, "url")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected validateSchedulerJobActionWebURL to trigger '%d' errors for '%s' - got '%d'", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidateSchedulerJobRecurrenceMonthDay(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    -32,
+			ErrCount: 1,
+		},
+		{
+			Value:    -31,
+			ErrCount: 0,
+		},
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    31,
+			ErrCount: 0,
+		},
+		{
+			Value:    32,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateSchedulerJobRecurrenceMonthDay(tc.Value, "month_days")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected validateSchedulerJobRecurrenceMonthDay to trigger '%d' errors for '%d' - got '%d'", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidateSchedulerJobRecurrenceMonthlyOccurrence(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    -6,
+			ErrCount: 1,
+		},
+		{
+			Value:    -5,
+			ErrCount: 0,
+		},
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    5,
+			ErrCount: 0,
+		},
+		{
+			Value:    6,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateSchedulerJobRecurrenceMonthlyOccurrence(tc.Value, "occurrence")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected validateSchedulerJobRecurrenceMonthlyOccurrence to trigger '%d' errors for '%d' - got '%d'", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testAccAzureRMSchedulerJob_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_scheduler_job_collection" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSchedulerJob_web(rInt int, location string, url string) string {
+	template := testAccAzureRMSchedulerJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_scheduler_job" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+
+  action_web {
+    url = "%s"
+  }
+}
+`, template, rInt, url)
+}
+
+func testAccAzureRMSchedulerJob_webComplete(rInt int, location string) string {
+	template := testAccAzureRMSchedulerJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_scheduler_job" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+  state               = "Disabled"
+
+  action_web {
+    url    = "https://example.com/endpoint"
+    method = "Put"
+    body   = "{\"hello\": \"world\"}"
+
+    headers = {
+      "Content-Type" = "application/json"
+      "X-Example"    = "terraform"
+    }
+  }
+
+  error_action_web {
+    url    = "https://example.com/error"
+    method = "Post"
+  }
+
+  retry {
+    interval = "00:05:00"
+    count    = 10
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSchedulerJob_storageQueue(rInt int, rString string, location string) string {
+	template := testAccAzureRMSchedulerJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestsa%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "scheduler-queue"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_scheduler_job" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+
+  action_storage_queue {
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    storage_queue_name   = "${azurerm_storage_queue.test.name}"
+    sas_token            = "?sv=2017-04-17&ss=q&srt=o&sp=a&se=2030-01-01T00:00:00Z&sig=placeholder"
+    message              = "storage message"
+  }
+
+  error_action_storage_queue {
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    storage_queue_name   = "${azurerm_storage_queue.test.name}"
+    sas_token            = "?sv=2017-04-17&ss=q&srt=o&sp=a&se=2030-01-01T00:00:00Z&sig=placeholder"
+    message              = "storage error message"
+  }
+}
+`, template, rString, rInt)
+}
+
+func testAccAzureRMSchedulerJob_recurrenceWeekly(rInt int, location string) string {
+	template := testAccAzureRMSchedulerJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_scheduler_job" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+  start_time          = "2030-07-07T07:07:07-07:00"
+
+  action_web {
+    url = "http://example.com"
+  }
+
+  recurrence {
+    frequency = "Week"
+    count     = 10
+    week_days = ["Sunday", "Saturday"]
+    hours     = [0, 12]
+    minutes   = [0, 30]
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSchedulerJob_recurrenceMonthly(rInt int, location string) string {
+	template := testAccAzureRMSchedulerJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_scheduler_job" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+
+  action_web {
+    url = "http://example.com"
+  }
+
+  recurrence {
+    frequency = "Month"
+    end_time  = "2031-07-17T07:07:07Z"
+
+    monthly_occurrences {
+      day        = "Sunday"
+      occurrence = 1
+    }
+
+    monthly_occurrences {
+      day        = "Friday"
+      occurrence = -1
+    }
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,357 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/disk"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSnapshotCreateUpdate,
+		Read:   resourceArmSnapshotRead,
+		Update: resourceArmSnapshotCreateUpdate,
+		Delete: resourceArmSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAzurermResourceGroupNameDiffSuppress,
+			},
+
+			"create_option": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(disk.Copy),
+					string(disk.Import),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"source_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"storage_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"disk_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDiskSizeGB,
+			},
+
+			"encryption_settings": snapshotEncryptionSettingsSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func snapshotEncryptionSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"disk_encryption_key": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"secret_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"source_vault_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"key_encryption_key": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"source_vault_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).snapshotsClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Snapshot creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	location := d.Get("location").(string)
+	createOption := d.Get("create_option").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	creationData := &disk.CreationData{
+		CreateOption: disk.CreateOption(createOption),
+	}
+
+	if strings.EqualFold(createOption, string(disk.Import)) {
+		sourceUri := d.Get("source_uri").(string)
+		if sourceUri == "" {
+			return fmt.Errorf("`source_uri` must be specified when `create_option` is `%s`", disk.Import)
+		}
+		creationData.SourceURI = utils.String(sourceUri)
+
+		if v := d.Get("storage_account_id").(string); v != "" {
+			creationData.StorageAccountID = utils.String(v)
+		}
+	} else if strings.EqualFold(createOption, string(disk.Copy)) {
+		sourceResourceId := d.Get("source_resource_id").(string)
+		if sourceResourceId == "" {
+			return fmt.Errorf("`source_resource_id` must be specified when `create_option` is `%s`", disk.Copy)
+		}
+		creationData.SourceResourceID = utils.String(sourceResourceId)
+	}
+
+	properties := disk.Snapshot{
+		Location: utils.String(location),
+		Properties: &disk.Properties{
+			CreationData:       creationData,
+			EncryptionSettings: expandSnapshotEncryptionSettings(d.Get("encryption_settings").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("disk_size_gb"); ok {
+		properties.Properties.DiskSizeGB = utils.Int32(int32(v.(int)))
+	}
+
+	_, createErr := client.CreateOrUpdate(resGroup, name, properties, make(chan struct{}))
+	if err := <-createErr; err != nil {
+		return fmt.Errorf("Error creating/updating Snapshot %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Snapshot %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Snapshot %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSnapshotRead(d, meta)
+}
+
+func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).snapshotsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["snapshots"]
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Snapshot %q (Resource Group %q) was not found - removing from state", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Snapshot %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		if data := props.CreationData; data != nil {
+			d.Set("create_option", string(data.CreateOption))
+
+			if data.SourceURI != nil {
+				d.Set("source_uri", *data.SourceURI)
+			}
+
+			if data.SourceResourceID != nil {
+				d.Set("source_resource_id", *data.SourceResourceID)
+			}
+
+			if data.StorageAccountID != nil {
+				d.Set("storage_account_id", *data.StorageAccountID)
+			}
+		}
+
+		if props.DiskSizeGB != nil {
+			d.Set("disk_size_gb", int(*props.DiskSizeGB))
+		}
+
+		if err := d.Set("encryption_settings", flattenSnapshotEncryptionSettings(props.EncryptionSettings)); err != nil {
+			return fmt.Errorf("Error flattening `encryption_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).snapshotsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["snapshots"]
+
+	_, deleteErr := client.Delete(resGroup, name, make(chan struct{}))
+	if err := <-deleteErr; err != nil {
+		return fmt.Errorf("Error deleting Snapshot %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandSnapshotEncryptionSettings(input []interface{}) *disk.EncryptionSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	settings := disk.EncryptionSettings{
+		Enabled: utils.Bool(raw["enabled"].(bool)),
+	}
+
+	if v := raw["disk_encryption_key"].([]interface{}); len(v) > 0 {
+		key := v[0].(map[string]interface{})
+		settings.DiskEncryptionKey = &disk.KeyVaultAndSecretReference{
+			SecretURL: utils.String(key["secret_url"].(string)),
+			SourceVault: &disk.SourceVault{
+				ID: utils.String(key["source_vault_id"].(string)),
+			},
+		}
+	}
+
+	if v := raw["key_encryption_key"].([]interface{}); len(v) > 0 {
+		key := v[0].(map[string]interface{})
+		settings.KeyEncryptionKey = &disk.KeyVaultAndKeyReference{
+			KeyURL: utils.String(key["key_url"].(string)),
+			SourceVault: &disk.SourceVault{
+				ID: utils.String(key["source_vault_id"].(string)),
+			},
+		}
+	}
+
+	return &settings
+}
+
+func flattenSnapshotEncryptionSettings(input *disk.EncryptionSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"enabled": input.Enabled != nil && *input.Enabled,
+	}
+
+	diskEncryptionKeys := make([]interface{}, 0)
+	if key := input.DiskEncryptionKey; key != nil {
+		secretUrl := ""
+		if key.SecretURL != nil {
+			secretUrl = *key.SecretURL
+		}
+
+		sourceVaultId := ""
+		if key.SourceVault != nil && key.SourceVault.ID != nil {
+			sourceVaultId = *key.SourceVault.ID
+		}
+
+		diskEncryptionKeys = append(diskEncryptionKeys, map[string]interface{}{
+			"secret_url":      secretUrl,
+			"source_vault_id": sourceVaultId,
+		})
+	}
+	output["disk_encryption_key"] = diskEncryptionKeys
+
+	keyEncryptionKeys := make([]interface{}, 0)
+	if key := input.KeyEncryptionKey; key != nil {
+		keyUrl := ""
+		if key.KeyURL != nil {
+			keyUrl = *key.KeyURL
+		}
+
+		sourceVaultId := ""
+		if key.SourceVault != nil && key.SourceVault.ID != nil {
+			sourceVaultId = *key.SourceVault.ID
+		}
+
+		keyEncryptionKeys = append(keyEncryptionKeys, map[string]interface{}{
+			"key_url":         keyUrl,
+			"source_vault_id": sourceVaultId,
+		})
+	}
+	output["key_encryption_key"] = keyEncryptionKeys
+
+	return []interface{}{output}
+}
@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSnapshot_fromManagedDisk(t *testing.T) {
+	resourceName := "azurerm_snapshot.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSnapshot_fromManagedDisk(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSnapshot_update(t *testing.T) {
+	resourceName := "azurerm_snapshot.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSnapshot_fromManagedDisk(ri, location)
+	postConfig := testAccAzureRMSnapshot_updated(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "10"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "20"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "acctest"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSnapshotExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		snapshotName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Snapshot: %s", snapshotName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).snapshotsClient
+		resp, err := client.Get(resourceGroup, snapshotName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Snapshot %q (Resource Group %q) does not exist", snapshotName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on snapshotsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSnapshotDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).snapshotsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_snapshot" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Snapshot %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSnapshot_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSnapshot_fromManagedDisk(rInt int, location string) string {
+	template := testAccAzureRMSnapshot_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_snapshot" "test" {
+  name                = "acctestss-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_resource_id  = "${azurerm_managed_disk.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSnapshot_updated(rInt int, location string) string {
+	template := testAccAzureRMSnapshot_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_snapshot" "test" {
+  name                = "acctestss-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_resource_id  = "${azurerm_managed_disk.test.id}"
+  disk_size_gb        = "20"
+
+  tags {
+    environment = "acctest"
+  }
+}
+`, template, rInt)
+}
@@ -152,6 +152,12 @@ func resourceArmStorageAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"blob_properties": storageServicePropertiesSchema(),
+
+			"queue_properties": storageServicePropertiesSchema(),
+
+			"table_properties": storageServicePropertiesSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -248,6 +254,16 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error waiting for Storage Account (%s) to become available: %s", storageAccountName, err)
 	}
 
+	for _, key := range []string{"blob_properties", "queue_properties", "table_properties"} {
+		if _, ok := d.GetOk(key); !ok {
+			continue
+		}
+
+		if err := updateStorageAccountServiceProperties(d, meta, resourceGroupName, storageAccountName, key); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -352,6 +368,18 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("enable_https_traffic_only")
 	}
 
+	for _, key := range []string{"blob_properties", "queue_properties", "table_properties"} {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		if err := updateStorageAccountServiceProperties(d, meta, resourceGroupName, storageAccountName, key); err != nil {
+			return err
+		}
+
+		d.SetPartial(key)
+	}
+
 	d.Partial(false)
 	return nil
 }
@@ -436,6 +464,20 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("name", resp.Name)
 
+	accountType := ""
+	if resp.Sku != nil {
+		accountType = string(resp.Sku.Name)
+	}
+	for _, key := range []string{"blob_properties", "queue_properties", "table_properties"} {
+		if !storageAccountSupportsService(string(resp.Kind), accountType, storageServicePropertiesBlocks[key]) {
+			continue
+		}
+
+		if err := readStorageAccountServiceProperties(d, meta, resGroup, name, key); err != nil {
+			return err
+		}
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
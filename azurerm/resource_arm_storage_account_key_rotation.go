@@ -0,0 +1,182 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageAccountKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageAccountKeyRotationCreate,
+		Read:   resourceArmStorageAccountKeyRotationRead,
+		Delete: resourceArmStorageAccountKeyRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageAccountName,
+			},
+
+			"resource_group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAzurermResourceGroupNameDiffSuppress,
+			},
+
+			"key_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"key1",
+					"key2",
+				}, false),
+			},
+
+			// any change to this map regenerates the key - it's otherwise opaque to the provider
+			"rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"blob_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_blob_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountKeyRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	log.Printf("[INFO] preparing arguments for AzureRM Storage Account Key Rotation.")
+
+	accountName := d.Get("storage_account_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	keyName := d.Get("key_name").(string)
+
+	account, err := client.GetProperties(resGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", accountName, resGroup, err)
+	}
+	if account.ID == nil {
+		return fmt.Errorf("Cannot read Storage Account %q (Resource Group %q) ID", accountName, resGroup)
+	}
+
+	azureRMLockByID(*account.ID)
+	defer azureRMUnlockByID(*account.ID)
+
+	log.Printf("[DEBUG] Regenerating Key %q for Storage Account %q (Resource Group %q)", keyName, accountName, resGroup)
+	parameters := storage.AccountRegenerateKeyParameters{
+		KeyName: utils.String(keyName),
+	}
+	if _, err := client.RegenerateKey(resGroup, accountName, parameters); err != nil {
+		return fmt.Errorf("Error regenerating Key %q for Storage Account %q (Resource Group %q): %+v", keyName, accountName, resGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/keys/%s", *account.ID, keyName))
+
+	return resourceArmStorageAccountKeyRotationRead(d, meta)
+}
+
+func resourceArmStorageAccountKeyRotationRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.storageServiceClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accountName := id.Path["storageAccounts"]
+	keyName := id.Path["keys"]
+
+	account, err := client.GetProperties(resGroup, accountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			log.Printf("[DEBUG] Storage Account %q (Resource Group %q) was not found - removing Key Rotation from state", accountName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", accountName, resGroup, err)
+	}
+
+	keys, err := client.ListKeys(resGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error listing Keys for Storage Account %q (Resource Group %q): %+v", accountName, resGroup, err)
+	}
+
+	accessKey := ""
+	if keys.Keys != nil {
+		for _, key := range *keys.Keys {
+			if key.KeyName != nil && strings.EqualFold(*key.KeyName, keyName) && key.Value != nil {
+				accessKey = *key.Value
+				break
+			}
+		}
+	}
+	if accessKey == "" {
+		return fmt.Errorf("Key %q was not found for Storage Account %q (Resource Group %q)", keyName, accountName, resGroup)
+	}
+
+	d.Set("storage_account_name", accountName)
+	d.Set("resource_group_name", resGroup)
+	d.Set("key_name", keyName)
+	d.Set("access_key", accessKey)
+
+	connectionString := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s",
+		accountName, accessKey, armClient.environment.StorageEndpointSuffix)
+	d.Set("connection_string", connectionString)
+
+	blobConnectionString := ""
+	if props := account.AccountProperties; props != nil && props.PrimaryEndpoints != nil && props.PrimaryEndpoints.Blob != nil {
+		blobConnectionString = fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s",
+			*props.PrimaryEndpoints.Blob, accountName, accessKey)
+	}
+	d.Set("blob_connection_string", blobConnectionString)
+
+	// only available for Geo-Redundant Storage Accounts
+	secondaryBlobConnectionString := ""
+	if props := account.AccountProperties; props != nil && props.SecondaryEndpoints != nil && props.SecondaryEndpoints.Blob != nil {
+		secondaryBlobConnectionString = fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s",
+			*props.SecondaryEndpoints.Blob, accountName, accessKey)
+	}
+	d.Set("secondary_blob_connection_string", secondaryBlobConnectionString)
+
+	return nil
+}
+
+func resourceArmStorageAccountKeyRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	// a regenerated key can't be restored, so there's nothing to do other than removing this from the state
+	log.Printf("[DEBUG] Removing Storage Account Key Rotation %q from the state - the Key is left as-is", d.Id())
+	return nil
+}
@@ -0,0 +1,152 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStorageAccountKeyRotation_basic(t *testing.T) {
+	resourceName := "azurerm_storage_account_key_rotation.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccAzureRMStorageAccountKeyRotation_basic(ri, rs, location, "1")
+	postConfig := testAccAzureRMStorageAccountKeyRotation_basic(ri, rs, location, "2")
+
+	var accessKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountKeyRotationMatchesAccount(resourceName, &accessKey),
+					resource.TestCheckResourceAttr(resourceName, "key_name", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger.%", "1"),
+					resource.TestMatchResourceAttr(resourceName, "connection_string", regexp.MustCompile("AccountKey=")),
+					resource.TestMatchResourceAttr(resourceName, "blob_connection_string", regexp.MustCompile("AccountKey=")),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountKeyRotationChanged(resourceName, &accessKey),
+					testCheckAzureRMStorageAccountKeyRotationMatchesAccount(resourceName, &accessKey),
+					testCheckAzureRMStorageAccountKeyRotationConnectionStrings(resourceName),
+				),
+			},
+		},
+	})
+}
+
+// testCheckAzureRMStorageAccountKeyRotationConnectionStrings confirms the Connection Strings were refreshed with the
+// rotated key within the same apply
+func testCheckAzureRMStorageAccountKeyRotationConnectionStrings(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		accountKey := fmt.Sprintf("AccountKey=%s", rs.Primary.Attributes["access_key"])
+		for _, key := range []string{"connection_string", "blob_connection_string"} {
+			if !strings.Contains(rs.Primary.Attributes[key], accountKey) {
+				return fmt.Errorf("Bad: %q for %q doesn't contain the rotated key", key, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testCheckAzureRMStorageAccountKeyRotationMatchesAccount confirms the key in the state is the current key for the
+// Storage Account, and stores it so subsequent steps can confirm it's been rotated
+func testCheckAzureRMStorageAccountKeyRotationMatchesAccount(name string, accessKey *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		accountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		keyName := rs.Primary.Attributes["key_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).storageServiceClient
+		resp, err := client.ListKeys(resourceGroup, accountName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Storage Account %q (Resource Group %q) does not exist", accountName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on storageServiceClient: %+v", err)
+		}
+
+		if resp.Keys != nil {
+			for _, key := range *resp.Keys {
+				if key.KeyName == nil || !strings.EqualFold(*key.KeyName, keyName) {
+					continue
+				}
+
+				if key.Value == nil || *key.Value != rs.Primary.Attributes["access_key"] {
+					return fmt.Errorf("Bad: Key %q in the state doesn't match the current Key for Storage Account %q", keyName, accountName)
+				}
+
+				*accessKey = *key.Value
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: Key %q was not found for Storage Account %q", keyName, accountName)
+	}
+}
+
+func testCheckAzureRMStorageAccountKeyRotationChanged(name string, accessKey *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.Attributes["access_key"] == *accessKey {
+			return fmt.Errorf("Bad: expected Key %q to have been rotated", rs.Primary.Attributes["key_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMStorageAccountKeyRotation_basic(rInt int, rString string, location string, trigger string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestsakr%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+}
+
+resource "azurerm_storage_account_key_rotation" "test" {
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  key_name             = "key1"
+
+  rotation_trigger {
+    rotated = "%s"
+  }
+}
+`, rInt, location, rString, trigger)
+}
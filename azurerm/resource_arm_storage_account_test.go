@@ -227,6 +227,57 @@ func TestAccAzureRMStorageAccount_blobStorageWithUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageAccount_serviceProperties(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccAzureRMStorageAccount_serviceProperties(ri, rs, location)
+	postConfig := testAccAzureRMStorageAccount_servicePropertiesUpdated(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.0.allowed_origins.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.0.max_age_in_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.logging.0.write", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.logging.0.retention_policy_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.hour_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.hour_metrics.0.include_apis", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.minute_metrics.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.0.delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "table_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_properties.0.minute_metrics.0.enabled", "true"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.hour_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.hour_metrics.0.retention_policy_days", "14"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "table_properties.0.minute_metrics.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageAccount_NonStandardCasing(t *testing.T) {
 	ri := acctest.RandInt()
 	rs := acctest.RandString(4)
@@ -496,6 +547,87 @@ resource "azurerm_storage_account" "testsa" {
 `, rInt, location, rString)
 }
 
+func testAccAzureRMStorageAccount_serviceProperties(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+    name = "testAccAzureRMSA-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+    name = "unlikely23exst2acct%s"
+    resource_group_name = "${azurerm_resource_group.testrg.name}"
+    location = "${azurerm_resource_group.testrg.location}"
+    account_type = "Standard_LRS"
+
+    blob_properties {
+        cors_rule {
+            allowed_origins = ["https://example.com", "https://www.example.com"]
+            allowed_methods = ["GET", "PUT"]
+            allowed_headers = ["x-ms-meta-*"]
+            exposed_headers = ["x-ms-meta-*"]
+            max_age_in_seconds = 3600
+        }
+
+        logging {
+            delete = false
+            read = true
+            write = true
+            retention_policy_days = 7
+        }
+
+        hour_metrics {
+            enabled = true
+            include_apis = true
+            retention_policy_days = 7
+        }
+    }
+
+    queue_properties {
+        logging {
+            delete = true
+            read = true
+            write = true
+        }
+    }
+
+    table_properties {
+        minute_metrics {
+            enabled = true
+            include_apis = false
+            retention_policy_days = 1
+        }
+    }
+}`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_servicePropertiesUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+    name = "testAccAzureRMSA-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+    name = "unlikely23exst2acct%s"
+    resource_group_name = "${azurerm_resource_group.testrg.name}"
+    location = "${azurerm_resource_group.testrg.location}"
+    account_type = "Standard_LRS"
+
+    blob_properties {
+        hour_metrics {
+            enabled = true
+            include_apis = false
+            retention_policy_days = 14
+        }
+    }
+
+    queue_properties {}
+
+    table_properties {}
+}`, rInt, location, rString)
+}
+
 func testAccAzureRMStorageAccountNonStandardCasing(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "testrg" {
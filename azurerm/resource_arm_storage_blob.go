@@ -2,11 +2,13 @@ package azurerm
 
 import (
 	"bytes"
-	"crypto/rand"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"os"
 	"runtime"
 	"strings"
@@ -20,6 +22,7 @@ func resourceArmStorageBlob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageBlobCreate,
 		Read:   resourceArmStorageBlobRead,
+		Update: resourceArmStorageBlobUpdate,
 		Exists: resourceArmStorageBlobExists,
 		Delete: resourceArmStorageBlobDelete,
 
@@ -60,14 +63,39 @@ func resourceArmStorageBlob() *schema.Resource {
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"source_uri"},
+				ConflictsWith: []string{"source_uri", "source_content"},
+			},
+			"source_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "source_uri"},
 			},
 			"source_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "source_content"},
+			},
+			"content_md5": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateArmStorageBlobContentMD5,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateArmStorageBlobMetadata,
 			},
 			"url": {
 				Type:     schema.TypeString,
@@ -95,7 +123,7 @@ func validateArmStorageBlobParallelism(v interface{}, k string) (ws []string, er
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Parallelism %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Parallelism %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -105,7 +133,7 @@ func validateArmStorageBlobAttempts(v interface{}, k string) (ws []string, error
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Attempts %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Attempts %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -115,7 +143,30 @@ func validateArmStorageBlobSize(v interface{}, k string) (ws []string, errors []
 	value := v.(int)
 
 	if value%512 != 0 {
-		errors = append(errors, fmt.Errorf("Blob Size %q is invalid, must be a multiple of 512", value))
+		errors = append(errors, fmt.Errorf("Blob Size %d is invalid, must be a multiple of 512", value))
+	}
+
+	return
+}
+
+func validateArmStorageBlobContentMD5(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if decoded, err := hex.DecodeString(value); err != nil || len(decoded) != md5.Size {
+		errors = append(errors, fmt.Errorf("%q must be a hex-encoded MD5 hash (e.g. the output of the `md5` function): %q", k, value))
+	}
+
+	return
+}
+
+func validateArmStorageBlobMetadata(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(map[string]interface{})
+
+	// the keys are returned from the API in lower-case, which would otherwise cause a diff
+	for key := range value {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("%q keys must be lower-case: %q", k, key))
+		}
 	}
 
 	return
@@ -124,12 +175,13 @@ func validateArmStorageBlobSize(v interface{}, k string) (ws []string, errors []
 func validateArmStorageBlobType(v interface{}, k string) (ws []string, errors []error) {
 	value := strings.ToLower(v.(string))
 	validTypes := map[string]struct{}{
-		"block": struct{}{},
-		"page":  struct{}{},
+		"append": struct{}{},
+		"block":  struct{}{},
+		"page":   struct{}{},
 	}
 
 	if _, ok := validTypes[value]; !ok {
-		errors = append(errors, fmt.Errorf("Blob type %q is invalid, must be %q or %q", value, "block", "page"))
+		errors = append(errors, fmt.Errorf("Blob type %q is invalid, must be %q, %q or %q", value, "append", "block", "page"))
 	}
 	return
 }
@@ -154,6 +206,7 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	sourceUri := d.Get("source_uri").(string)
 
 	log.Printf("[INFO] Creating blob %q in storage account %q", name, storageAccountName)
+	contentMD5 := ""
 	if sourceUri != "" {
 		options := &storage.CopyOptions{}
 		container := blobClient.GetContainerReference(cont)
@@ -164,29 +217,41 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	} else {
 		switch strings.ToLower(blobType) {
-		case "block":
-			options := &storage.PutBlobOptions{}
-			container := blobClient.GetContainerReference(cont)
-			blob := container.GetBlobReference(name)
-			err := blob.CreateBlockBlob(options)
-			if err != nil {
-				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+		case "append":
+			if d.Get("source").(string) != "" || d.Get("source_content").(string) != "" {
+				contentMD5, err = resourceArmStorageBlobUploadFromConfig(d, blobClient)
+				if err != nil {
+					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				}
+			} else {
+				options := &storage.PutBlobOptions{}
+				container := blobClient.GetContainerReference(cont)
+				blob := container.GetBlobReference(name)
+				err := blob.PutAppendBlob(options)
+				if err != nil {
+					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				}
 			}
-
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
-				if err := resourceArmStorageBlobBlockUploadFromSource(cont, name, source, blobClient, parallelism, attempts); err != nil {
+		case "block":
+			// the blob is created when the block list is committed - which allows an interrupted upload to be resumed
+			if d.Get("source").(string) != "" || d.Get("source_content").(string) != "" {
+				contentMD5, err = resourceArmStorageBlobUploadFromConfig(d, blobClient)
+				if err != nil {
+					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				}
+			} else {
+				options := &storage.PutBlobOptions{}
+				container := blobClient.GetContainerReference(cont)
+				blob := container.GetBlobReference(name)
+				err := blob.CreateBlockBlob(options)
+				if err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 				}
 			}
 		case "page":
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
-				if err := resourceArmStorageBlobPageUploadFromSource(cont, name, source, blobClient, parallelism, attempts); err != nil {
+			if d.Get("source").(string) != "" || d.Get("source_content").(string) != "" {
+				contentMD5, err = resourceArmStorageBlobUploadFromConfig(d, blobClient)
+				if err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 				}
 			} else {
@@ -204,10 +269,235 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if err := resourceArmStorageBlobSetProperties(d, blobClient, contentMD5); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("metadata"); ok {
+		if err := resourceArmStorageBlobSetMetadata(d, blobClient); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(name)
 	return resourceArmStorageBlobRead(d, meta)
 }
 
+func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+
+	// the content is re-uploaded in-place when either the source or its hash changes
+	contentMD5 := ""
+	if d.HasChange("source") || d.HasChange("source_content") || d.HasChange("content_md5") {
+		log.Printf("[INFO] Re-uploading the content for blob %q in storage account %q", name, storageAccountName)
+		contentMD5, err = resourceArmStorageBlobUploadFromConfig(d, blobClient)
+		if err != nil {
+			return fmt.Errorf("Error updating storage blob %q: %s", name, err)
+		}
+	}
+
+	if contentMD5 != "" || d.HasChange("content_type") || d.HasChange("cache_control") {
+		if err := resourceArmStorageBlobSetProperties(d, blobClient, contentMD5); err != nil {
+			return err
+		}
+	}
+
+	// re-uploading the content also clears the metadata
+	if contentMD5 != "" || d.HasChange("metadata") {
+		if err := resourceArmStorageBlobSetMetadata(d, blobClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStorageBlobRead(d, meta)
+}
+
+// resourceArmStorageBlobUploadFromConfig uploads the content from either `source` or `source_content`, returning the
+// hex-encoded MD5 hash of the uploaded content - or an empty string if there's no content to upload.
+func resourceArmStorageBlobUploadFromConfig(d *schema.ResourceData, client *storage.BlobStorageClient) (string, error) {
+	name := d.Get("name").(string)
+	cont := d.Get("storage_container_name").(string)
+	blobType := strings.ToLower(d.Get("type").(string))
+	source := d.Get("source").(string)
+	sourceContent := d.Get("source_content").(string)
+	parallelism := d.Get("parallelism").(int)
+	attempts := d.Get("attempts").(int)
+
+	if sourceContent != "" {
+		reader := strings.NewReader(sourceContent)
+
+		switch blobType {
+		case "append":
+			if err := resourceArmStorageBlobAppendUpload(cont, name, "source_content", reader, reader.Size(), client, attempts); err != nil {
+				return "", err
+			}
+		case "block":
+			if err := resourceArmStorageBlobBlockUpload(cont, name, "source_content", reader, reader.Size(), client, parallelism, attempts); err != nil {
+				return "", err
+			}
+		default:
+			return "", fmt.Errorf("`source_content` can only be used with a `type` of `append` or `block`")
+		}
+
+		return resourceArmStorageBlobContentMD5(strings.NewReader(sourceContent))
+	}
+
+	if source == "" {
+		return "", nil
+	}
+
+	switch blobType {
+	case "append":
+		if err := resourceArmStorageBlobAppendUploadFromSource(cont, name, source, client, attempts); err != nil {
+			return "", err
+		}
+	case "block":
+		if err := resourceArmStorageBlobBlockUploadFromSource(cont, name, source, client, parallelism, attempts); err != nil {
+			return "", err
+		}
+	case "page":
+		if err := resourceArmStorageBlobPageUploadFromSource(cont, name, source, client, parallelism, attempts); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("`source` can only be used with a `type` of `append`, `block` or `page`")
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return "", fmt.Errorf("Error opening source file %q: %s", source, err)
+	}
+	defer file.Close()
+
+	return resourceArmStorageBlobContentMD5(file)
+}
+
+// resourceArmStorageBlobUploadProgress logs the progress of an upload each time another percent has completed
+type resourceArmStorageBlobUploadProgress struct {
+	name     string
+	total    int64
+	uploaded int64
+	percent  int64
+	lock     sync.Mutex
+}
+
+func (p *resourceArmStorageBlobUploadProgress) add(size int64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.uploaded += size
+	if p.total == 0 {
+		return
+	}
+
+	percent := p.uploaded * 100 / p.total
+	if percent > p.percent {
+		p.percent = percent
+		log.Printf("[DEBUG] Uploaded %d of %d bytes (%d%%) for storage blob %q", p.uploaded, p.total, percent, p.name)
+	}
+}
+
+// resourceArmStorageBlobSetProperties sets the properties managed by Terraform on the blob. Since Set Blob Properties
+// replaces all of the properties, those which aren't managed by Terraform are retrieved and sent back unchanged.
+func resourceArmStorageBlobSetProperties(d *schema.ResourceData, client *storage.BlobStorageClient, contentMD5 string) error {
+	name := d.Get("name").(string)
+	container := client.GetContainerReference(d.Get("storage_container_name").(string))
+
+	existing := container.GetBlobReference(name)
+	if err := existing.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving properties for storage blob %q: %s", name, err)
+	}
+
+	// a new reference is used since the page blob specific properties (such as the content length) would otherwise be sent
+	blob := container.GetBlobReference(name)
+	blob.Properties.ContentEncoding = existing.Properties.ContentEncoding
+	blob.Properties.ContentLanguage = existing.Properties.ContentLanguage
+	blob.Properties.ContentDisposition = existing.Properties.ContentDisposition
+	blob.Properties.ContentType = existing.Properties.ContentType
+	blob.Properties.ContentMD5 = existing.Properties.ContentMD5
+	blob.Properties.CacheControl = d.Get("cache_control").(string)
+
+	if v := d.Get("content_type").(string); v != "" {
+		blob.Properties.ContentType = v
+	}
+
+	if contentMD5 != "" {
+		encoded, err := resourceArmStorageBlobContentMD5ToBase64(contentMD5)
+		if err != nil {
+			return err
+		}
+		blob.Properties.ContentMD5 = encoded
+	}
+
+	log.Printf("[INFO] Setting properties for storage blob %q", name)
+	if err := blob.SetProperties(&storage.SetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error setting properties for storage blob %q: %s", name, err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageBlobSetMetadata(d *schema.ResourceData, client *storage.BlobStorageClient) error {
+	name := d.Get("name").(string)
+	container := client.GetContainerReference(d.Get("storage_container_name").(string))
+	blob := container.GetBlobReference(name)
+
+	metadata := make(map[string]string)
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+	blob.Metadata = storage.BlobMetadata(metadata)
+
+	log.Printf("[INFO] Setting metadata for storage blob %q", name)
+	if err := blob.SetMetadata(&storage.SetBlobMetadataOptions{}); err != nil {
+		return fmt.Errorf("Error setting metadata for storage blob %q: %s", name, err)
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobContentMD5 returns the hex-encoded MD5 hash of the content, to match Terraform's `md5` function
+func resourceArmStorageBlobContentMD5(reader io.Reader) (string, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", fmt.Errorf("Error computing the MD5 hash of the content: %s", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// the Storage API uses a base64-encoded MD5 hash, whereas we expose a hex-encoded hash
+func resourceArmStorageBlobContentMD5ToBase64(input string) (string, error) {
+	decoded, err := hex.DecodeString(input)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding Content MD5 %q: %s", input, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(decoded), nil
+}
+
+func resourceArmStorageBlobContentMD5FromBase64(input string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding Content MD5 %q: %s", input, err)
+	}
+
+	return hex.EncodeToString(decoded), nil
+}
+
 type resourceArmStorageBlobPage struct {
 	offset  int64
 	section *io.SectionReader
@@ -248,6 +538,11 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source string,
 	}
 	close(pages)
 
+	progress := &resourceArmStorageBlobUploadProgress{
+		name:  name,
+		total: total,
+	}
+
 	for i := 0; i < workerCount; i++ {
 		go resourceArmStorageBlobPageUploadWorker(resourceArmStorageBlobPageUploadContext{
 			container: container,
@@ -259,6 +554,7 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source string,
 			errors:    errors,
 			wg:        wg,
 			attempts:  attempts,
+			progress:  progress,
 		})
 	}
 
@@ -342,6 +638,7 @@ type resourceArmStorageBlobPageUploadContext struct {
 	errors    chan error
 	wg        *sync.WaitGroup
 	attempts  int
+	progress  *resourceArmStorageBlobUploadProgress
 }
 
 func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUploadContext) {
@@ -381,6 +678,7 @@ func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUpload
 			continue
 		}
 
+		ctx.progress.add(page.section.Size())
 		ctx.wg.Done()
 	}
 }
@@ -391,25 +689,63 @@ type resourceArmStorageBlobBlock struct {
 }
 
 func resourceArmStorageBlobBlockUploadFromSource(container, name, source string, client *storage.BlobStorageClient, parallelism, attempts int) error {
-	workerCount := parallelism * runtime.NumCPU()
-
 	file, err := os.Open(source)
 	if err != nil {
 		return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
 	}
 	defer file.Close()
 
-	blockList, parts, err := resourceArmStorageBlobBlockSplit(file)
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Error stating source file %q: %s", source, err)
+	}
+
+	return resourceArmStorageBlobBlockUpload(container, name, source, file, info.Size(), client, parallelism, attempts)
+}
+
+// resourceArmStorageBlobBlockUpload uploads the content as a series of blocks. Since the Block ID's are derived from the
+// content of each block, blocks which have already been uploaded (for example by an interrupted upload, or a previous
+// version of the content) are retrieved via Get Block List and skipped, rather than being uploaded again.
+func resourceArmStorageBlobBlockUpload(container, name, source string, reader io.ReaderAt, size int64, client *storage.BlobStorageClient, parallelism, attempts int) error {
+	workerCount := parallelism * runtime.NumCPU()
+
+	blockList, parts, err := resourceArmStorageBlobBlockSplit(reader, size, source)
 	if err != nil {
 		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
 	}
 
-	wg := &sync.WaitGroup{}
-	blocks := make(chan resourceArmStorageBlobBlock, len(parts))
-	errors := make(chan error, len(parts))
+	containerReference := client.GetContainerReference(container)
+	blobReference := containerReference.GetBlobReference(name)
 
-	wg.Add(len(parts))
+	existing, err := resourceArmStorageBlobExistingBlocks(blobReference)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the existing blocks for source file %q: %s", source, err)
+	}
+
+	progress := &resourceArmStorageBlobUploadProgress{
+		name:  name,
+		total: size,
+	}
+
+	remaining := make([]resourceArmStorageBlobBlock, 0)
 	for _, p := range parts {
+		if existingSize, ok := existing[p.id]; ok && existingSize == p.section.Size() {
+			progress.add(p.section.Size())
+			continue
+		}
+		remaining = append(remaining, p)
+	}
+
+	if skipped := len(parts) - len(remaining); skipped > 0 {
+		log.Printf("[DEBUG] Skipping %d of %d blocks which have already been uploaded for storage blob %q", skipped, len(parts), name)
+	}
+
+	wg := &sync.WaitGroup{}
+	blocks := make(chan resourceArmStorageBlobBlock, len(remaining))
+	errors := make(chan error, len(remaining))
+
+	wg.Add(len(remaining))
+	for _, p := range remaining {
 		blocks <- p
 	}
 	close(blocks)
@@ -424,6 +760,7 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source string,
 			errors:    errors,
 			wg:        wg,
 			attempts:  attempts,
+			progress:  progress,
 		})
 	}
 
@@ -433,8 +770,6 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source string,
 		return fmt.Errorf("Error while uploading source file %q: %s", source, <-errors)
 	}
 
-	containerReference := client.GetContainerReference(container)
-	blobReference := containerReference.GetBlobReference(name)
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
@@ -444,48 +779,100 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source string,
 	return nil
 }
 
-func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
-	const (
-		idSize          = 64
-		blockSize int64 = 4 * 1024 * 1024
-	)
+// resourceArmStorageBlobExistingBlocks returns the size of each of the committed and uncommitted blocks, keyed by ID
+func resourceArmStorageBlobExistingBlocks(blob *storage.Blob) (map[string]int64, error) {
+	blocks := make(map[string]int64)
+
+	resp, err := blob.GetBlockList(storage.BlockListTypeAll, &storage.GetBlockListOptions{})
+	if err != nil {
+		if storageErr, ok := err.(storage.AzureStorageServiceError); ok && storageErr.StatusCode == http.StatusNotFound {
+			return blocks, nil
+		}
+		return nil, err
+	}
+
+	for _, block := range resp.CommittedBlocks {
+		blocks[block.Name] = block.Size
+	}
+
+	for _, block := range resp.UncommittedBlocks {
+		blocks[block.Name] = block.Size
+	}
+
+	return blocks, nil
+}
+
+func resourceArmStorageBlobBlockSplit(reader io.ReaderAt, size int64, source string) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
 	var parts []resourceArmStorageBlobBlock
 	var blockList []storage.Block
 
-	info, err := file.Stat()
+	blockSize, err := resourceArmStorageBlobBlockSize(size)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), err)
+		return nil, nil, err
 	}
 
-	for i := int64(0); i < info.Size(); i = i + blockSize {
-		entropy := make([]byte, idSize)
-		_, err = rand.Read(entropy)
-		if err != nil {
-			return nil, nil, fmt.Errorf("Error generating a random block ID for source file %q: %s", file.Name(), err)
-		}
-
+	for i := int64(0); i < size; i = i + blockSize {
 		sectionSize := blockSize
-		remainder := info.Size() - i
+		remainder := size - i
 		if remainder < blockSize {
 			sectionSize = remainder
 		}
 
+		// the block is hashed as a stream so only a single block is held in memory at once (by each worker)
+		section := io.NewSectionReader(reader, i, sectionSize)
+		hash := md5.New()
+		if _, err := io.Copy(hash, section); err != nil {
+			return nil, nil, fmt.Errorf("Error reading source file %q at offset %d: %s", source, i, err)
+		}
+
 		block := storage.Block{
-			ID:     base64.StdEncoding.EncodeToString(entropy),
-			Status: storage.BlockStatusUncommitted,
+			ID:     resourceArmStorageBlobBlockID(len(blockList), hash.Sum(nil)),
+			Status: storage.BlockStatusLatest,
 		}
 
 		blockList = append(blockList, block)
 
 		parts = append(parts, resourceArmStorageBlobBlock{
 			id:      block.ID,
-			section: io.NewSectionReader(file, i, sectionSize),
+			section: io.NewSectionReader(reader, i, sectionSize),
 		})
 	}
 
 	return blockList, parts, nil
 }
 
+// resourceArmStorageBlobBlockSize returns the size of each block - which is increased for large files, since a
+// blob can contain at most 50,000 blocks.
+func resourceArmStorageBlobBlockSize(size int64) (int64, error) {
+	const (
+		maxBlocks               = 50000
+		minBlockSize      int64 = 4 * 1024 * 1024
+		blockSizeStepping int64 = 1024 * 1024
+	)
+
+	if size <= minBlockSize*maxBlocks {
+		return minBlockSize, nil
+	}
+
+	blockSize := (size + maxBlocks - 1) / maxBlocks
+	if remainder := blockSize % blockSizeStepping; remainder != 0 {
+		blockSize += blockSizeStepping - remainder
+	}
+
+	if blockSize > storage.MaxBlobBlockSize {
+		return 0, fmt.Errorf("The source is %d bytes, which exceeds the maximum size of a block blob", size)
+	}
+
+	return blockSize, nil
+}
+
+// resourceArmStorageBlobBlockID returns a Block ID which is derived from both the position and the hash of the block,
+// such that unchanged blocks can be identified. All of the Block ID's within a blob must be the same length.
+func resourceArmStorageBlobBlockID(index int, hash []byte) string {
+	id := fmt.Sprintf("%05d-%x", index, hash)
+	return base64.StdEncoding.EncodeToString([]byte(id))
+}
+
 type resourceArmStorageBlobBlockUploadContext struct {
 	client    *storage.BlobStorageClient
 	container string
@@ -495,13 +882,14 @@ type resourceArmStorageBlobBlockUploadContext struct {
 	blocks    chan resourceArmStorageBlobBlock
 	errors    chan error
 	wg        *sync.WaitGroup
+	progress  *resourceArmStorageBlobUploadProgress
 }
 
 func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUploadContext) {
 	for block := range ctx.blocks {
 		buffer := make([]byte, block.section.Size())
 
-		_, err := block.section.Read(buffer)
+		_, err := io.ReadFull(block.section, buffer)
 		if err != nil {
 			ctx.errors <- fmt.Errorf("Error reading source file %q: %s", ctx.source, err)
 			ctx.wg.Done()
@@ -523,10 +911,75 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 			continue
 		}
 
+		ctx.progress.add(block.section.Size())
 		ctx.wg.Done()
 	}
 }
 
+func resourceArmStorageBlobAppendUploadFromSource(container, name, source string, client *storage.BlobStorageClient, attempts int) error {
+	file, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Error stating source file %q: %s", source, err)
+	}
+
+	return resourceArmStorageBlobAppendUpload(container, name, source, file, info.Size(), client, attempts)
+}
+
+// resourceArmStorageBlobAppendUpload (re-)creates the append blob and then appends the content in order - as such
+// these blocks can't be uploaded in parallel.
+func resourceArmStorageBlobAppendUpload(container, name, source string, reader io.ReaderAt, size int64, client *storage.BlobStorageClient, attempts int) error {
+	const blockSize int64 = 4 * 1024 * 1024
+
+	containerReference := client.GetContainerReference(container)
+	blob := containerReference.GetBlobReference(name)
+	if err := blob.PutAppendBlob(&storage.PutBlobOptions{}); err != nil {
+		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+	}
+
+	progress := &resourceArmStorageBlobUploadProgress{
+		name:  name,
+		total: size,
+	}
+
+	for offset := int64(0); offset < size; offset += blockSize {
+		sectionSize := blockSize
+		if remainder := size - offset; remainder < blockSize {
+			sectionSize = remainder
+		}
+
+		buffer := make([]byte, sectionSize)
+		if _, err := io.ReadFull(io.NewSectionReader(reader, offset, sectionSize), buffer); err != nil {
+			return fmt.Errorf("Error reading source file %q at offset %d: %s", source, offset, err)
+		}
+
+		var err error
+		for i := 0; i < attempts; i++ {
+			// the append position ensures a retried block can't be appended twice
+			position := uint(offset)
+			options := &storage.AppendBlockOptions{
+				AppendPosition: &position,
+			}
+			err = blob.AppendBlock(buffer, options)
+			if err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("Error appending block at offset %d for source file %q: %s", offset, source, err)
+		}
+
+		progress.add(sectionSize)
+	}
+
+	return nil
+}
+
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
@@ -564,6 +1017,46 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("url", url)
 
+	if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving properties for storage blob %q: %s", name, err)
+	}
+
+	contentMD5 := ""
+	if blob.Properties.ContentMD5 != "" {
+		contentMD5, err = resourceArmStorageBlobContentMD5FromBase64(blob.Properties.ContentMD5)
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("content_md5", contentMD5)
+	d.Set("content_type", blob.Properties.ContentType)
+	d.Set("cache_control", blob.Properties.CacheControl)
+
+	// if the remote content no longer matches the `source_content` it's removed from the state, so it's re-uploaded
+	if sourceContent := d.Get("source_content").(string); sourceContent != "" {
+		expected, err := resourceArmStorageBlobContentMD5(strings.NewReader(sourceContent))
+		if err != nil {
+			return err
+		}
+
+		if expected != contentMD5 {
+			log.Printf("[DEBUG] Content MD5 for storage blob %q (%q) doesn't match `source_content` (%q)", name, contentMD5, expected)
+			d.Set("source_content", "")
+		}
+	}
+
+	if err := blob.GetMetadata(&storage.GetBlobMetadataOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving metadata for storage blob %q: %s", name, err)
+	}
+
+	metadata := make(map[string]interface{})
+	for k, v := range blob.Metadata {
+		metadata[k] = v
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return fmt.Errorf("Error flattening `metadata`: %+v", err)
+	}
+
 	return nil
 }
 
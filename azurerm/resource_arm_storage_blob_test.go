@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"strings"
@@ -28,6 +29,10 @@ func TestResourceAzureRMStorageBlobType_validation(t *testing.T) {
 			Value:    "page",
 			ErrCount: 0,
 		},
+		{
+			Value:    "append",
+			ErrCount: 0,
+		},
 		{
 			Value:    "block",
 			ErrCount: 0,
@@ -143,6 +148,197 @@ func TestResourceAzureRMStorageBlobAttempts_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMStorageBlobContentMD5_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "b10a8db164e0754105b7a99be72e3fe5",
+			ErrCount: 0,
+		},
+		{
+			Value:    "B10A8DB164E0754105B7A99BE72E3FE5",
+			ErrCount: 0,
+		},
+		{
+			Value:    "sQqNsWTgdUEFt6mb5y4/5Q==",
+			ErrCount: 1,
+		},
+		{
+			Value:    "b10a8db164e0754105b7a99be72e3f",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageBlobContentMD5(tc.Value, "content_md5")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for content_md5 %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobMetadata_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"hello": "world"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"Hello": "world"},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageBlobMetadata(tc.Value, "metadata")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for metadata %+v but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobBlockSize(t *testing.T) {
+	const mb int64 = 1024 * 1024
+	cases := []struct {
+		Size        int64
+		Expected    int64
+		ShouldError bool
+	}{
+		{
+			Size:     0,
+			Expected: 4 * mb,
+		},
+		{
+			Size:     25 * mb,
+			Expected: 4 * mb,
+		},
+		{
+			Size:     50000 * 4 * mb,
+			Expected: 4 * mb,
+		},
+		{
+			Size:     50000*4*mb + 1,
+			Expected: 5 * mb,
+		},
+		{
+			Size:     50000 * 100 * mb,
+			Expected: 100 * mb,
+		},
+		{
+			Size:        50000*100*mb + 1,
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		blockSize, err := resourceArmStorageBlobBlockSize(tc.Size)
+		if tc.ShouldError {
+			if err == nil {
+				t.Fatalf("Expected an error for a size of %d", tc.Size)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for a size of %d but got: %+v", tc.Size, err)
+		}
+
+		if blockSize != tc.Expected {
+			t.Fatalf("Expected a block size of %d for a size of %d but got %d", tc.Expected, tc.Size, blockSize)
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobBlockSplit(t *testing.T) {
+	const blockSize = 4 * 1024 * 1024
+	content := strings.Repeat("a", blockSize) + strings.Repeat("b", blockSize) + "c"
+
+	blockList, parts, err := resourceArmStorageBlobBlockSplit(strings.NewReader(content), int64(len(content)), "test")
+	if err != nil {
+		t.Fatalf("Error splitting content: %+v", err)
+	}
+
+	if len(blockList) != 3 || len(parts) != 3 {
+		t.Fatalf("Expected 3 blocks but got %d blocks and %d parts", len(blockList), len(parts))
+	}
+
+	if parts[2].section.Size() != 1 {
+		t.Fatalf("Expected the last block to contain 1 byte but got %d", parts[2].section.Size())
+	}
+
+	for i, block := range blockList {
+		if block.ID != parts[i].id {
+			t.Fatalf("Expected block %d to have the ID %q but got %q", i, block.ID, parts[i].id)
+		}
+
+		// all of the Block ID's within a blob must be the same length
+		if len(block.ID) != len(blockList[0].ID) {
+			t.Fatalf("Expected block %d to have an ID of length %d but got %d", i, len(blockList[0].ID), len(block.ID))
+		}
+	}
+
+	// only the blocks which have changed should have a different ID
+	updated := strings.Repeat("a", blockSize) + strings.Repeat("d", blockSize) + "c"
+	updatedBlockList, _, err := resourceArmStorageBlobBlockSplit(strings.NewReader(updated), int64(len(updated)), "test")
+	if err != nil {
+		t.Fatalf("Error splitting updated content: %+v", err)
+	}
+
+	if updatedBlockList[0].ID != blockList[0].ID {
+		t.Fatalf("Expected the ID of the first (unchanged) block to be the same")
+	}
+
+	if updatedBlockList[1].ID == blockList[1].ID {
+		t.Fatalf("Expected the ID of the second (changed) block to differ")
+	}
+
+	if updatedBlockList[2].ID != blockList[2].ID {
+		t.Fatalf("Expected the ID of the third (unchanged) block to be the same")
+	}
+}
+
+func TestResourceAzureRMStorageBlobContentMD5(t *testing.T) {
+	hash, err := resourceArmStorageBlobContentMD5(strings.NewReader("Hello World"))
+	if err != nil {
+		t.Fatalf("Error computing the MD5 hash: %+v", err)
+	}
+
+	expected := "b10a8db164e0754105b7a99be72e3fe5"
+	if hash != expected {
+		t.Fatalf("Expected the MD5 hash to be %q but got %q", expected, hash)
+	}
+
+	encoded, err := resourceArmStorageBlobContentMD5ToBase64(hash)
+	if err != nil {
+		t.Fatalf("Error encoding the MD5 hash: %+v", err)
+	}
+
+	expectedEncoded := "sQqNsWTgdUEFt6mb5y4/5Q=="
+	if encoded != expectedEncoded {
+		t.Fatalf("Expected the encoded MD5 hash to be %q but got %q", expectedEncoded, encoded)
+	}
+
+	decoded, err := resourceArmStorageBlobContentMD5FromBase64(encoded)
+	if err != nil {
+		t.Fatalf("Error decoding the MD5 hash: %+v", err)
+	}
+
+	if decoded != hash {
+		t.Fatalf("Expected the decoded MD5 hash to be %q but got %q", hash, decoded)
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	rs := strings.ToLower(acctest.RandString(11))
@@ -220,6 +416,154 @@ func TestAccAzureRMStorageBlobBlock_source(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_sourceUpdated(t *testing.T) {
+	resourceName := "azurerm_storage_blob.source"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(sourceBlob.Name())
+
+	writeSource := func(content string) {
+		if err := ioutil.WriteFile(sourceBlob.Name(), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write to source blob: %+v", err)
+		}
+	}
+	writeSource("first version")
+
+	config := testAccAzureRMStorageBlobBlock_sourceUpdated(ri, rs, sourceBlob.Name(), testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, storage.BlobTypeBlock, "first version"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "e9e2371570daec2e7b70faa4f0f1eab8"),
+				),
+			},
+			{
+				PreConfig: func() { writeSource("second version") },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, storage.BlobTypeBlock, "second version"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "f084be37ed84e9d0d2a02d4d4be59745"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Hello World", "text/plain", "first")
+	postConfig := testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Goodbye World", "text/html", "second")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, storage.BlobTypeBlock, "Hello World"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "b10a8db164e0754105b7a99be72e3fe5"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "no-cache"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "first"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, storage.BlobTypeBlock, "Goodbye World"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "2a799bfecbec1e7c6cebdc26391aee0d"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/html"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobAppend_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageBlobAppend_sourceContent(ri, rs, location, "Hello World")
+	postConfig := testAccAzureRMStorageBlobAppend_sourceContent(ri, rs, location, "Goodbye World")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, storage.BlobTypeAppend, "Hello World"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "b10a8db164e0754105b7a99be72e3fe5"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, storage.BlobTypeAppend, "Goodbye World"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "2a799bfecbec1e7c6cebdc26391aee0d"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobAppend_source(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(sourceBlob.Name())
+
+	_, err = io.CopyN(sourceBlob, rand.Reader, 10*1024*1024+123)
+	if err != nil {
+		t.Fatalf("Failed to write random test to source blob")
+	}
+
+	err = sourceBlob.Close()
+	if err != nil {
+		t.Fatalf("Failed to close source blob")
+	}
+
+	config := testAccAzureRMStorageBlobAppend_source(ri, rs, sourceBlob.Name(), testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile("azurerm_storage_blob.source", storage.BlobTypeAppend, sourceBlob.Name()),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageBlobPage_source(t *testing.T) {
 	ri := acctest.RandInt()
 	rs := strings.ToLower(acctest.RandString(11))
@@ -392,6 +736,17 @@ func testCheckAzureRMStorageBlobDisappears(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMStorageBlobMatchesFile(name string, kind storage.BlobType, filePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		expectedContents, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		return testCheckAzureRMStorageBlobMatchesContent(name, kind, string(expectedContents))(s)
+	}
+}
+
+func testCheckAzureRMStorageBlobMatchesContent(name string, kind storage.BlobType, expectedContents string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
 		rs, ok := s.RootModule().Resources[name]
@@ -442,12 +797,7 @@ func testCheckAzureRMStorageBlobMatchesFile(name string, kind storage.BlobType,
 		}
 		defer blob.Close()
 
-		expectedContents, err := ioutil.ReadFile(filePath)
-		if err != nil {
-			return err
-		}
-
-		if string(contents) != string(expectedContents) {
+		if string(contents) != expectedContents {
 			return fmt.Errorf("Bad: Storage Blob %q (storage container: %q) does not match contents", name, storageContainerName)
 		}
 
@@ -571,6 +921,150 @@ resource "azurerm_storage_blob" "source" {
 `, rInt, location, rString, sourceBlobName)
 }
 
+func testAccAzureRMStorageBlobBlock_sourceUpdated(rInt int, rString string, sourceBlobName string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "source" {
+    name = "acctestacc%s"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    account_type = "Standard_LRS"
+}
+
+resource "azurerm_storage_container" "source" {
+    name = "source"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.source.name}"
+    container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "source" {
+    name = "source.txt"
+
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.source.name}"
+    storage_container_name = "${azurerm_storage_container.source.name}"
+
+    type = "block"
+    source = "%s"
+    content_md5 = "${md5(file("%s"))}"
+}
+`, rInt, location, rString, sourceBlobName, sourceBlobName)
+}
+
+func testAccAzureRMStorageBlobBlock_sourceContent(rInt int, rString string, location string, content string, contentType string, version string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name = "acctestacc%s"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    account_type = "Standard_LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+    name = "content"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+    name = "content.txt"
+
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    storage_container_name = "${azurerm_storage_container.test.name}"
+
+    type = "block"
+    source_content = "%s"
+    content_type = "%s"
+    cache_control = "no-cache"
+
+    metadata {
+        version = "%s"
+    }
+}
+`, rInt, location, rString, content, contentType, version)
+}
+
+func testAccAzureRMStorageBlobAppend_sourceContent(rInt int, rString string, location string, content string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name = "acctestacc%s"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    account_type = "Standard_LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+    name = "content"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+    name = "content.log"
+
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    storage_container_name = "${azurerm_storage_container.test.name}"
+
+    type = "append"
+    source_content = "%s"
+}
+`, rInt, location, rString, content)
+}
+
+func testAccAzureRMStorageBlobAppend_source(rInt int, rString string, sourceBlobName string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "source" {
+    name = "acctestacc%s"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    account_type = "Standard_LRS"
+}
+
+resource "azurerm_storage_container" "source" {
+    name = "source"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.source.name}"
+    container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "source" {
+    name = "source.log"
+
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.source.name}"
+    storage_container_name = "${azurerm_storage_container.source.name}"
+
+    type = "append"
+    source = "%s"
+    attempts = 2
+}
+`, rInt, location, rString, sourceBlobName)
+}
+
 func testAccAzureRMStorageBlobPage_source(rInt int, rString string, sourceBlobName string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -15,6 +15,7 @@ func resourceArmStorageContainer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageContainerCreate,
 		Read:   resourceArmStorageContainerRead,
+		Update: resourceArmStorageContainerUpdate,
 		Exists: resourceArmStorageContainerExists,
 		Delete: resourceArmStorageContainerDelete,
 
@@ -42,6 +43,7 @@ func resourceArmStorageContainer() *schema.Resource {
 				Default:      "private",
 				ValidateFunc: validateArmStorageContainerAccessType,
 			},
+			"access_policy": storageAccessPolicySchema("rwd"),
 			"properties": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -115,8 +117,14 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating container %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
+	accessPolicies, err := expandStorageContainerAccessPolicies(d.Get("access_policy").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	permissions := storage.ContainerPermissions{
-		AccessType: accessType,
+		AccessType:     accessType,
+		AccessPolicies: accessPolicies,
 	}
 	permissionOptions := &storage.SetContainerPermissionOptions{}
 	err = reference.SetPermissions(permissions, permissionOptions)
@@ -128,6 +136,50 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 	return resourceArmStorageContainerRead(d, meta)
 }
 
+func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+
+	if d.HasChange("access_policy") {
+		reference := blobClient.GetContainerReference(name)
+
+		// Set Container ACL replaces both the public access level and the access policies
+		existing, err := reference.GetPermissions(&storage.GetContainerPermissionOptions{})
+		if err != nil {
+			return fmt.Errorf("Error retrieving permissions for container %q in storage account %q: %s", name, storageAccountName, err)
+		}
+
+		accessPolicies, err := expandStorageContainerAccessPolicies(d.Get("access_policy").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		permissions := storage.ContainerPermissions{
+			AccessType:     existing.AccessType,
+			AccessPolicies: accessPolicies,
+		}
+
+		log.Printf("[INFO] Updating access policies for container %q in storage account %q", name, storageAccountName)
+		if err := reference.SetPermissions(permissions, &storage.SetContainerPermissionOptions{}); err != nil {
+			return fmt.Errorf("Error setting permissions for container %s in storage account %s: %+v", name, storageAccountName, err)
+		}
+	}
+
+	return resourceArmStorageContainerRead(d, meta)
+}
+
 // resourceAzureStorageContainerRead does all the necessary API calls to
 // read the status of the storage container off Azure.
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
@@ -173,6 +225,16 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 	if !found {
 		log.Printf("[INFO] Storage container %q does not exist in account %q, removing from state...", name, storageAccountName)
 		d.SetId("")
+		return nil
+	}
+
+	permissions, err := blobClient.GetContainerReference(name).GetPermissions(&storage.GetContainerPermissionOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving permissions for container %q in storage account %q: %s", name, storageAccountName, err)
+	}
+
+	if err := d.Set("access_policy", flattenStorageContainerAccessPolicies(permissions.AccessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `access_policy`: %+v", err)
 	}
 
 	return nil
@@ -240,3 +302,38 @@ func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{})
 	d.SetId("")
 	return nil
 }
+
+func expandStorageContainerAccessPolicies(input []interface{}) ([]storage.ContainerAccessPolicy, error) {
+	policies, err := expandStorageAccessPolicies(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]storage.ContainerAccessPolicy, 0, len(policies))
+	for _, policy := range policies {
+		output = append(output, storage.ContainerAccessPolicy{
+			ID:         policy.ID,
+			StartTime:  policy.Start,
+			ExpiryTime: policy.Expiry,
+			CanRead:    strings.Contains(policy.Permissions, "r"),
+			CanWrite:   strings.Contains(policy.Permissions, "w"),
+			CanDelete:  strings.Contains(policy.Permissions, "d"),
+		})
+	}
+
+	return output, nil
+}
+
+func flattenStorageContainerAccessPolicies(input []storage.ContainerAccessPolicy) []interface{} {
+	policies := make([]storageAccessPolicy, 0, len(input))
+	for _, policy := range input {
+		policies = append(policies, storageAccessPolicy{
+			ID:          policy.ID,
+			Start:       policy.StartTime,
+			Expiry:      policy.ExpiryTime,
+			Permissions: storageAccessPolicyPermissions("rwd", policy.CanRead, policy.CanWrite, policy.CanDelete),
+		})
+	}
+
+	return flattenStorageAccessPolicies(policies)
+}
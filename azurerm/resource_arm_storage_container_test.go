@@ -81,6 +81,52 @@ func TestAccAzureRMStorageContainer_root(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageContainer_accessPolicy(t *testing.T) {
+	var c storage.Container
+
+	resourceName := "azurerm_storage_container.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageContainer_accessPolicy(ri, rs, location, "rw")
+	postConfig := testAccAzureRMStorageContainer_accessPolicy(ri, rs, location, "rwd")
+	removedConfig := testAccAzureRMStorageContainer_basic(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.id", "policy1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.start", "2018-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.expiry", "2028-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "rw"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "rwd"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageContainerExists(name string, c *storage.Container) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -295,3 +341,37 @@ resource "azurerm_storage_container" "test" {
 }
 `, rInt, location, rString)
 }
+
+func testAccAzureRMStorageContainer_accessPolicy(rInt int, rString string, location string, permissions string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestacc%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+
+  tags {
+    environment = "staging"
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+
+  access_policy {
+    id          = "policy1"
+    start       = "2018-01-01T00:00:00Z"
+    expiry      = "2028-01-01T00:00:00Z"
+    permissions = "%s"
+  }
+}
+`, rInt, location, rString, permissions)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +14,7 @@ func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageQueueCreate,
 		Read:   resourceArmStorageQueueRead,
+		Update: resourceArmStorageQueueUpdate,
 		Exists: resourceArmStorageQueueExists,
 		Delete: resourceArmStorageQueueDelete,
 
@@ -33,6 +35,7 @@ func resourceArmStorageQueue() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"access_policy": storageAccessPolicySchema("raup"),
 		},
 	}
 }
@@ -90,11 +93,60 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating storage queue on Azure: %s", err)
 	}
 
+	if v, ok := d.GetOk("access_policy"); ok {
+		if err := resourceArmStorageQueueSetAccessPolicies(queueReference, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(name)
 	return resourceArmStorageQueueRead(d, meta)
 }
 
+func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	if d.HasChange("access_policy") {
+		queueReference := queueClient.GetQueueReference(d.Get("name").(string))
+		if err := resourceArmStorageQueueSetAccessPolicies(queueReference, d.Get("access_policy").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStorageQueueRead(d, meta)
+}
+
+func resourceArmStorageQueueSetAccessPolicies(queueReference *storage.Queue, input []interface{}) error {
+	accessPolicies, err := expandStorageQueueAccessPolicies(input)
+	if err != nil {
+		return err
+	}
+
+	permissions := storage.QueuePermissions{
+		AccessPolicies: accessPolicies,
+	}
+
+	log.Printf("[INFO] Setting access policies for storage queue %q", queueReference.Name)
+	if err := queueReference.SetPermissions(permissions, &storage.SetQueuePermissionOptions{}); err != nil {
+		return fmt.Errorf("Error setting access policies for storage queue %q: %s", queueReference.Name, err)
+	}
+
+	return nil
+}
+
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
 
 	exists, err := resourceArmStorageQueueExists(d, meta)
 	if err != nil {
@@ -106,6 +158,24 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	queueClient, _, err := armClient.getQueueServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	permissions, err := queueClient.GetQueueReference(name).GetPermissions(&storage.GetQueuePermissionOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving access policies for storage queue %q: %s", name, err)
+	}
+
+	if err := d.Set("access_policy", flattenStorageQueueAccessPolicies(permissions.AccessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `access_policy`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -169,3 +239,39 @@ func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) err
 	d.SetId("")
 	return nil
 }
+
+func expandStorageQueueAccessPolicies(input []interface{}) ([]storage.QueueAccessPolicy, error) {
+	policies, err := expandStorageAccessPolicies(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]storage.QueueAccessPolicy, 0, len(policies))
+	for _, policy := range policies {
+		output = append(output, storage.QueueAccessPolicy{
+			ID:         policy.ID,
+			StartTime:  policy.Start,
+			ExpiryTime: policy.Expiry,
+			CanRead:    strings.Contains(policy.Permissions, "r"),
+			CanAdd:     strings.Contains(policy.Permissions, "a"),
+			CanUpdate:  strings.Contains(policy.Permissions, "u"),
+			CanProcess: strings.Contains(policy.Permissions, "p"),
+		})
+	}
+
+	return output, nil
+}
+
+func flattenStorageQueueAccessPolicies(input []storage.QueueAccessPolicy) []interface{} {
+	policies := make([]storageAccessPolicy, 0, len(input))
+	for _, policy := range input {
+		policies = append(policies, storageAccessPolicy{
+			ID:          policy.ID,
+			Start:       policy.StartTime,
+			Expiry:      policy.ExpiryTime,
+			Permissions: storageAccessPolicyPermissions("raup", policy.CanRead, policy.CanAdd, policy.CanUpdate, policy.CanProcess),
+		})
+	}
+
+	return flattenStorageAccessPolicies(policies)
+}
@@ -70,6 +70,50 @@ func TestAccAzureRMStorageQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageQueue_accessPolicy(t *testing.T) {
+	resourceName := "azurerm_storage_queue.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageQueue_accessPolicy(ri, rs, location, "ra")
+	postConfig := testAccAzureRMStorageQueue_accessPolicy(ri, rs, location, "raup")
+	removedConfig := testAccAzureRMStorageQueue_basic(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageQueueExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.id", "policy1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.start", "2018-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.expiry", "2028-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "ra"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageQueueExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "raup"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageQueueExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageQueueExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -169,3 +213,36 @@ resource "azurerm_storage_queue" "test" {
 }
 `, rInt, location, rString, rInt)
 }
+
+func testAccAzureRMStorageQueue_accessPolicy(rInt int, rString string, location string, permissions string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestacc%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+
+  tags {
+    environment = "staging"
+  }
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "mysamplequeue-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+
+  access_policy {
+    id          = "policy1"
+    start       = "2018-01-01T00:00:00Z"
+    expiry      = "2028-01-01T00:00:00Z"
+    permissions = "%s"
+  }
+}
+`, rInt, location, rString, rInt, permissions)
+}
@@ -13,6 +13,7 @@ func resourceArmStorageShare() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageShareCreate,
 		Read:   resourceArmStorageShareRead,
+		Update: resourceArmStorageShareUpdate,
 		Exists: resourceArmStorageShareExists,
 		Delete: resourceArmStorageShareDelete,
 
@@ -39,6 +40,7 @@ func resourceArmStorageShare() *schema.Resource {
 				ForceNew: true,
 				Default:  0,
 			},
+			"access_policy": storageAccessPolicySchema("rcwdl"),
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -78,10 +80,54 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	reference.SetProperties(options)
 
+	if v, ok := d.GetOk("access_policy"); ok {
+		if err := resourceArmStorageShareSetAccessPolicies(armClient, resourceGroupName, storageAccountName, name, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(name)
 	return resourceArmStorageShareRead(d, meta)
 }
 
+func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+	name := d.Get("name").(string)
+
+	if d.HasChange("access_policy") {
+		log.Printf("[INFO] Updating the Access Policies for share %q in storage account %q", name, storageAccountName)
+		if err := resourceArmStorageShareSetAccessPolicies(armClient, resourceGroupName, storageAccountName, name, d.Get("access_policy").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStorageShareRead(d, meta)
+}
+
+func resourceArmStorageShareSetAccessPolicies(armClient *ArmClient, resourceGroupName string, storageAccountName string, name string, input []interface{}) error {
+	aclClient, accountExists, err := armClient.getShareACLClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	accessPolicies, err := expandStorageShareAccessPolicies(input)
+	if err != nil {
+		return err
+	}
+
+	if err := aclClient.SetShareACL(name, accessPolicies); err != nil {
+		return fmt.Errorf("Error setting the Access Policies for share %q in storage account %q: %s", name, storageAccountName, err)
+	}
+
+	return nil
+}
+
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
@@ -117,6 +163,20 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("url", url)
 
+	aclClient, _, err := armClient.getShareACLClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+
+	accessPolicies, err := aclClient.GetShareACL(name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Access Policies for share %q in storage account %q: %s", name, storageAccountName, err)
+	}
+
+	if err := d.Set("access_policy", flattenStorageShareAccessPolicies(accessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `access_policy`: %+v", err)
+	}
+
 	return nil
 }
 
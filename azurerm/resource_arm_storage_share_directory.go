@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmStorageShareDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageShareDirectoryCreate,
+		Read:   resourceArmStorageShareDirectoryRead,
+		Update: resourceArmStorageShareDirectoryUpdate,
+		Delete: resourceArmStorageShareDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmStorageShareDirectoryImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareDirectoryName,
+			},
+			"share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+			"resource_group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAzurermResourceGroupNameDiffSuppress,
+			},
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateArmStorageBlobMetadata,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateArmStorageShareDirectoryName allows nested directories to be specified as a path, e.g. `parent/child`
+func validateArmStorageShareDirectoryName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be empty", k))
+		return
+	}
+	if strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q cannot begin or end with a slash: %q", k, value))
+	}
+	if strings.Contains(value, "//") {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive slashes: %q", k, value))
+	}
+	if strings.ContainsAny(value, `\:|<>*?"`) {
+		errors = append(errors, fmt.Errorf("%q cannot contain any of the characters `\\:|<>*?\"`: %q", k, value))
+	}
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 1024 characters: %q", k, value))
+	}
+	return
+}
+
+func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+	shareName := d.Get("share_name").(string)
+
+	directory := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+	directory.Metadata = expandStorageShareMetadata(d.Get("metadata").(map[string]interface{}))
+
+	log.Printf("[INFO] Creating Directory %q in Share %q (Storage Account %q)", name, shareName, storageAccountName)
+	if err := directory.Create(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error creating Directory %q in Share %q (Storage Account %q): %s", name, shareName, storageAccountName, err)
+	}
+
+	d.SetId(directory.URL())
+	return resourceArmStorageShareDirectoryRead(d, meta)
+}
+
+func resourceArmStorageShareDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+	shareName := d.Get("share_name").(string)
+
+	if d.HasChange("metadata") {
+		directory := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+		directory.Metadata = expandStorageShareMetadata(d.Get("metadata").(map[string]interface{}))
+
+		log.Printf("[INFO] Updating the Metadata for Directory %q in Share %q (Storage Account %q)", name, shareName, storageAccountName)
+		if err := directory.SetMetadata(&storage.FileRequestOptions{}); err != nil {
+			return fmt.Errorf("Error updating the Metadata for Directory %q in Share %q (Storage Account %q): %s", name, shareName, storageAccountName, err)
+		}
+	}
+
+	return resourceArmStorageShareDirectoryRead(d, meta)
+}
+
+func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing Directory %q from state", storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	shareName := d.Get("share_name").(string)
+
+	directory := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+	exists, err := directory.Exists()
+	if err != nil {
+		return fmt.Errorf("Error testing existence of Directory %q in Share %q (Storage Account %q): %s", name, shareName, storageAccountName, err)
+	}
+	if !exists {
+		log.Printf("[INFO] Directory %q no longer exists in Share %q, removing from state...", name, shareName)
+		d.SetId("")
+		return nil
+	}
+
+	if err := directory.FetchAttributes(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving the Metadata for Directory %q in Share %q (Storage Account %q): %s", name, shareName, storageAccountName, err)
+	}
+
+	if err := d.Set("metadata", flattenStorageShareMetadata(directory.Metadata)); err != nil {
+		return fmt.Errorf("Error flattening `metadata`: %+v", err)
+	}
+	d.Set("url", directory.URL())
+
+	return nil
+}
+
+func resourceArmStorageShareDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the Directory won't exist", storageAccountName)
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	shareName := d.Get("share_name").(string)
+
+	directory := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+	if _, err := directory.DeleteIfExists(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error deleting Directory %q in Share %q (Storage Account %q): %s", name, shareName, storageAccountName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceArmStorageShareDirectoryImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := parseStorageDataPlaneID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.SplitN(id.Path, "/", 2)
+	if id.Service != "file" || len(segments) != 2 || segments[1] == "" {
+		return nil, fmt.Errorf("Expected the ID %q to be in the format `https://{account}.file.{suffix}/{share}/{directory}`", d.Id())
+	}
+
+	resourceGroup, err := meta.(*ArmClient).findResourceGroupForStorageAccount(id.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("storage_account_name", id.AccountName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("share_name", segments[0])
+	d.Set("name", strings.TrimSuffix(segments[1], "/"))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandStorageShareMetadata(input map[string]interface{}) map[string]string {
+	output := make(map[string]string, len(input))
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+func flattenStorageShareMetadata(input map[string]string) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		output[k] = v
+	}
+	return output
+}
@@ -0,0 +1,259 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStorageShareDirectory_basic(t *testing.T) {
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageShareDirectory_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_nested(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageShareDirectory_nested(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists("azurerm_storage_share_directory.parent"),
+					testCheckAzureRMStorageShareDirectoryExists("azurerm_storage_share_directory.child"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_metadata(t *testing.T) {
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageShareDirectory_metadata(ri, rs, location, "first")
+	postConfig := testAccAzureRMStorageShareDirectory_metadata(ri, rs, location, "second")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "first"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageShareDirectoryExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		exists, err := testCheckAzureRMStorageShareDirectoryExistsInAccount(rs)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: Directory %q (Share %q) does not exist", rs.Primary.Attributes["name"], rs.Primary.Attributes["share_name"])
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareDirectoryDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_share_directory" {
+			continue
+		}
+
+		exists, err := testCheckAzureRMStorageShareDirectoryExistsInAccount(rs)
+		if err != nil {
+			// If we can't get keys then the directory can't exist
+			return nil
+		}
+		if exists {
+			return fmt.Errorf("Bad: Directory %q (Share %q) still exists", rs.Primary.Attributes["name"], rs.Primary.Attributes["share_name"])
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMStorageShareDirectoryExistsInAccount(rs *terraform.ResourceState) (bool, error) {
+	name := rs.Primary.Attributes["name"]
+	shareName := rs.Primary.Attributes["share_name"]
+	storageAccountName := rs.Primary.Attributes["storage_account_name"]
+	resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+
+	armClient := testAccProvider.Meta().(*ArmClient)
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return false, err
+	}
+	if !accountExists {
+		return false, nil
+	}
+
+	share := fileClient.GetShareReference(shareName)
+	shareExists, err := share.Exists()
+	if err != nil {
+		return false, err
+	}
+	if !shareExists {
+		return false, nil
+	}
+
+	return share.GetRootDirectoryReference().GetDirectoryReference(name).Exists()
+}
+
+func TestValidateArmStorageShareDirectoryName(t *testing.T) {
+	validNames := []string{
+		"dir",
+		"parent/child",
+		"with spaces",
+		"a.b-c_d",
+	}
+	for _, v := range validNames {
+		_, errors := validateArmStorageShareDirectoryName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Storage Share Directory Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"/leading",
+		"trailing/",
+		"double//slash",
+		"back\\slash",
+		"question?",
+		"colon:",
+		strings.Repeat("a", 1025),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateArmStorageShareDirectoryName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Storage Share Directory Name", v)
+		}
+	}
+}
+
+func testAccAzureRMStorageShareDirectory_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestacc%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageShareDirectory_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  share_name           = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_nested(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "parent" {
+  name                 = "parent"
+  share_name           = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_storage_share_directory" "child" {
+  name                 = "${azurerm_storage_share_directory.parent.name}/child"
+  share_name           = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_metadata(rInt int, rString string, location string, value string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  share_name           = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+
+  metadata {
+    hello = "%s"
+  }
+}
+`, template, value)
+}
@@ -0,0 +1,351 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// the File Service accepts at most 4MB in a single Put Range operation
+const storageShareFileRangeSize = 4 * 1024 * 1024
+
+func resourceArmStorageShareFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageShareFileCreate,
+		Read:   resourceArmStorageShareFileRead,
+		Update: resourceArmStorageShareFileUpdate,
+		Delete: resourceArmStorageShareFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmStorageShareFileImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareFileName,
+			},
+			"share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareDirectoryName,
+			},
+			"resource_group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAzurermResourceGroupNameDiffSuppress,
+			},
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_md5": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateArmStorageBlobContentMD5,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateArmStorageBlobMetadata,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateArmStorageShareFileName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be empty", k))
+		return
+	}
+	if strings.ContainsAny(value, `/\:|<>*?"`) {
+		errors = append(errors, fmt.Errorf("%q cannot contain any of the characters `/\\:|<>*?\"`: %q", k, value))
+	}
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters: %q", k, value))
+	}
+	return
+}
+
+func resourceArmStorageShareFileCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	file := resourceArmStorageShareFileReference(d, fileClient)
+	if err := resourceArmStorageShareFileUpload(d, file); err != nil {
+		return err
+	}
+
+	d.SetId(file.URL())
+	return resourceArmStorageShareFileRead(d, meta)
+}
+
+func resourceArmStorageShareFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+	file := resourceArmStorageShareFileReference(d, fileClient)
+
+	// re-creating the file replaces the content, properties and metadata in one go
+	if d.HasChange("source") || d.HasChange("content_md5") {
+		if err := resourceArmStorageShareFileUpload(d, file); err != nil {
+			return err
+		}
+
+		return resourceArmStorageShareFileRead(d, meta)
+	}
+
+	if d.HasChange("content_type") {
+		// Set File Properties replaces all of the properties (including the length) so the existing ones are sent back
+		if err := file.FetchAttributes(&storage.FileRequestOptions{}); err != nil {
+			return fmt.Errorf("Error retrieving properties for File %q: %s", name, err)
+		}
+
+		file.Properties.Type = d.Get("content_type").(string)
+
+		log.Printf("[INFO] Setting properties for File %q", name)
+		if err := file.SetProperties(&storage.FileRequestOptions{}); err != nil {
+			return fmt.Errorf("Error setting properties for File %q: %s", name, err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		file.Metadata = expandStorageShareMetadata(d.Get("metadata").(map[string]interface{}))
+
+		log.Printf("[INFO] Setting metadata for File %q", name)
+		if err := file.SetMetadata(&storage.FileRequestOptions{}); err != nil {
+			return fmt.Errorf("Error setting metadata for File %q: %s", name, err)
+		}
+	}
+
+	return resourceArmStorageShareFileRead(d, meta)
+}
+
+func resourceArmStorageShareFileReference(d *schema.ResourceData, client *storage.FileServiceClient) *storage.File {
+	directory := client.GetShareReference(d.Get("share_name").(string)).GetRootDirectoryReference()
+	if path := d.Get("path").(string); path != "" {
+		directory = directory.GetDirectoryReference(path)
+	}
+
+	return directory.GetFileReference(d.Get("name").(string))
+}
+
+// resourceArmStorageShareFileUpload (re-)creates the file with the content from `source`, if specified. Files are
+// created at their full size and then written to in ranges; the MD5 hash is computed up-front so it can be set
+// alongside the other properties when the file is created, since the File Service doesn't compute one itself.
+func resourceArmStorageShareFileUpload(d *schema.ResourceData, file *storage.File) error {
+	name := d.Get("name").(string)
+
+	var reader io.ReaderAt = bytes.NewReader([]byte{})
+	size := int64(0)
+
+	if source := d.Get("source").(string); source != "" {
+		f, err := os.Open(source)
+		if err != nil {
+			return fmt.Errorf("Error opening source file %q for File %q: %s", source, name, err)
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading source file %q for File %q: %s", source, name, err)
+		}
+
+		reader = f
+		size = info.Size()
+	}
+
+	contentMD5, err := resourceArmStorageBlobContentMD5(io.NewSectionReader(reader, 0, size))
+	if err != nil {
+		return err
+	}
+	encodedMD5, err := resourceArmStorageBlobContentMD5ToBase64(contentMD5)
+	if err != nil {
+		return err
+	}
+
+	file.Properties = storage.FileProperties{
+		MD5:  encodedMD5,
+		Type: d.Get("content_type").(string),
+	}
+	file.Metadata = expandStorageShareMetadata(d.Get("metadata").(map[string]interface{}))
+
+	log.Printf("[INFO] Creating File %q (%d bytes)", name, size)
+	if err := file.Create(uint64(size), &storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error creating File %q: %s", name, err)
+	}
+
+	for offset := int64(0); offset < size; offset += storageShareFileRangeSize {
+		length := int64(storageShareFileRangeSize)
+		if remaining := size - offset; remaining < length {
+			length = remaining
+		}
+
+		fileRange := storage.FileRange{
+			Start: uint64(offset),
+			End:   uint64(offset + length - 1),
+		}
+		log.Printf("[DEBUG] Writing range %s of File %q", fileRange, name)
+		if err := file.WriteRange(io.NewSectionReader(reader, offset, length), fileRange, nil); err != nil {
+			return fmt.Errorf("Error writing range %s of File %q: %s", fileRange, name, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageShareFileRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing File %q from state", storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	file := resourceArmStorageShareFileReference(d, fileClient)
+
+	exists, err := file.Exists()
+	if err != nil {
+		return fmt.Errorf("Error testing existence of File %q: %s", name, err)
+	}
+	if !exists {
+		log.Printf("[INFO] File %q no longer exists, removing from state...", name)
+		d.SetId("")
+		return nil
+	}
+
+	if err := file.FetchAttributes(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving properties for File %q: %s", name, err)
+	}
+
+	contentMD5 := ""
+	if file.Properties.MD5 != "" {
+		contentMD5, err = resourceArmStorageBlobContentMD5FromBase64(file.Properties.MD5)
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("content_md5", contentMD5)
+	d.Set("content_type", file.Properties.Type)
+
+	if err := d.Set("metadata", flattenStorageShareMetadata(file.Metadata)); err != nil {
+		return fmt.Errorf("Error flattening `metadata`: %+v", err)
+	}
+
+	d.Set("url", file.URL())
+
+	return nil
+}
+
+func resourceArmStorageShareFileDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the File won't exist", storageAccountName)
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	file := resourceArmStorageShareFileReference(d, fileClient)
+
+	if _, err := file.DeleteIfExists(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error deleting File %q: %s", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceArmStorageShareFileImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := parseStorageDataPlaneID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(id.Path, "/")
+	if id.Service != "file" || len(segments) < 2 || segments[len(segments)-1] == "" {
+		return nil, fmt.Errorf("Expected the ID %q to be in the format `https://{account}.file.{suffix}/{share}/{path}/{file}`", d.Id())
+	}
+
+	resourceGroup, err := meta.(*ArmClient).findResourceGroupForStorageAccount(id.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("storage_account_name", id.AccountName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("share_name", segments[0])
+	d.Set("path", strings.Join(segments[1:len(segments)-1], "/"))
+	d.Set("name", segments[len(segments)-1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,265 @@
+package azurerm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStorageShareFile_basic(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageShareFile_empty(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					// the MD5 of empty content
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "d41d8cd98f00b204e9800998ecf8427e"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareFile_source(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	sourceFile, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source file")
+	}
+	defer os.Remove(sourceFile.Name())
+
+	if err := ioutil.WriteFile(sourceFile.Name(), []byte("first version"), 0644); err != nil {
+		t.Fatalf("Failed to write local source file: %s", err)
+	}
+
+	preConfig := testAccAzureRMStorageShareFile_source(ri, rs, location, sourceFile.Name(), "text/plain")
+	postConfig := testAccAzureRMStorageShareFile_source(ri, rs, location, sourceFile.Name(), "application/octet-stream")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "e9e2371570daec2e7b70faa4f0f1eab8"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(sourceFile.Name(), []byte("second version"), 0644); err != nil {
+						t.Fatalf("Failed to write local source file: %s", err)
+					}
+				},
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "f084be37ed84e9d0d2a02d4d4be59745"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareFile_inDirectory(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageShareFile_inDirectory(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "path", "dir"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageShareFileExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		exists, err := testCheckAzureRMStorageShareFileExistsInAccount(rs)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: File %q (Share %q) does not exist", rs.Primary.Attributes["name"], rs.Primary.Attributes["share_name"])
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareFileDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_share_file" {
+			continue
+		}
+
+		exists, err := testCheckAzureRMStorageShareFileExistsInAccount(rs)
+		if err != nil {
+			// If we can't get keys then the file can't exist
+			return nil
+		}
+		if exists {
+			return fmt.Errorf("Bad: File %q (Share %q) still exists", rs.Primary.Attributes["name"], rs.Primary.Attributes["share_name"])
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMStorageShareFileExistsInAccount(rs *terraform.ResourceState) (bool, error) {
+	name := rs.Primary.Attributes["name"]
+	shareName := rs.Primary.Attributes["share_name"]
+	path := rs.Primary.Attributes["path"]
+	storageAccountName := rs.Primary.Attributes["storage_account_name"]
+	resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+
+	armClient := testAccProvider.Meta().(*ArmClient)
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return false, err
+	}
+	if !accountExists {
+		return false, nil
+	}
+
+	share := fileClient.GetShareReference(shareName)
+	shareExists, err := share.Exists()
+	if err != nil {
+		return false, err
+	}
+	if !shareExists {
+		return false, nil
+	}
+
+	directory := share.GetRootDirectoryReference()
+	if path != "" {
+		directory = directory.GetDirectoryReference(path)
+	}
+
+	return directory.GetFileReference(name).Exists()
+}
+
+func TestValidateArmStorageShareFileName(t *testing.T) {
+	validNames := []string{
+		"file",
+		"file.txt",
+		"with spaces.tar.gz",
+	}
+	for _, v := range validNames {
+		_, errors := validateArmStorageShareFileName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Storage Share File Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"dir/file",
+		"star*",
+		"pipe|",
+		strings.Repeat("a", 256),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateArmStorageShareFileName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Storage Share File Name", v)
+		}
+	}
+}
+
+func testAccAzureRMStorageShareFile_empty(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "test" {
+  name                 = "empty.txt"
+  share_name           = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareFile_source(rInt int, rString string, location string, source string, contentType string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "test" {
+  name                 = "source.txt"
+  share_name           = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  source               = "%s"
+  content_md5          = "${md5(file("%s"))}"
+  content_type         = "%s"
+
+  metadata {
+    hello = "world"
+  }
+}
+`, template, source, source, contentType)
+}
+
+func testAccAzureRMStorageShareFile_inDirectory(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  share_name           = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_storage_share_file" "test" {
+  name                 = "file.txt"
+  share_name           = "${azurerm_storage_share.test.name}"
+  path                 = "${azurerm_storage_share_directory.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, template)
+}
@@ -34,6 +34,52 @@ func TestAccAzureRMStorageShare_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageShare_accessPolicy(t *testing.T) {
+	var sS storage.Share
+
+	resourceName := "azurerm_storage_share.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageShare_accessPolicy(ri, rs, location, "rl")
+	postConfig := testAccAzureRMStorageShare_accessPolicy(ri, rs, location, "rcwdl")
+	removedConfig := testAccAzureRMStorageShare_basic(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareExists(resourceName, &sS),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.id", "policy1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.start", "2018-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.expiry", "2028-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "rl"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareExists(resourceName, &sS),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "rcwdl"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareExists(resourceName, &sS),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageShare_disappears(t *testing.T) {
 	var sS storage.Share
 
@@ -243,3 +289,36 @@ resource "azurerm_storage_share" "test" {
     storage_account_name = "${azurerm_storage_account.test.name}"
 }`, rInt, location, rString)
 }
+
+func testAccAzureRMStorageShare_accessPolicy(rInt int, rString string, location string, permissions string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestacc%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+
+  tags {
+    environment = "staging"
+  }
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+
+  access_policy {
+    id          = "policy1"
+    start       = "2018-01-01T00:00:00Z"
+    expiry      = "2028-01-01T00:00:00Z"
+    permissions = "%s"
+  }
+}
+`, rInt, location, rString, permissions)
+}
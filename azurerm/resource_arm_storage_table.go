@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +14,7 @@ func resourceArmStorageTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageTableCreate,
 		Read:   resourceArmStorageTableRead,
+		Update: resourceArmStorageTableUpdate,
 		Delete: resourceArmStorageTableDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -32,6 +34,7 @@ func resourceArmStorageTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"access_policy": storageAccessPolicySchema("raud"),
 		},
 	}
 }
@@ -78,11 +81,55 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating table %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
+	if v, ok := d.GetOk("access_policy"); ok {
+		if err := resourceArmStorageTableSetAccessPolicies(table, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(name)
 
 	return resourceArmStorageTableRead(d, meta)
 }
 
+func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	if d.HasChange("access_policy") {
+		table := tableClient.GetTableReference(d.Get("name").(string))
+		if err := resourceArmStorageTableSetAccessPolicies(table, d.Get("access_policy").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStorageTableRead(d, meta)
+}
+
+func resourceArmStorageTableSetAccessPolicies(table *storage.Table, input []interface{}) error {
+	accessPolicies, err := expandStorageTableAccessPolicies(input)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Setting access policies for storage table %q", table.Name)
+	if err := table.SetPermissions(accessPolicies, uint(60), &storage.TableOptions{}); err != nil {
+		return fmt.Errorf("Error setting access policies for storage table %q: %s", table.Name, err)
+	}
+
+	return nil
+}
+
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
@@ -119,6 +166,16 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 	if !found {
 		log.Printf("[INFO] Storage table %q does not exist in account %q, removing from state...", name, storageAccountName)
 		d.SetId("")
+		return nil
+	}
+
+	accessPolicies, err := tableClient.GetTableReference(name).GetPermissions(60, &storage.TableOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving access policies for storage table %q in account %q: %s", name, storageAccountName, err)
+	}
+
+	if err := d.Set("access_policy", flattenStorageTableAccessPolicies(accessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `access_policy`: %+v", err)
 	}
 
 	return nil
@@ -152,3 +209,39 @@ func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) err
 	d.SetId("")
 	return nil
 }
+
+func expandStorageTableAccessPolicies(input []interface{}) ([]storage.TableAccessPolicy, error) {
+	policies, err := expandStorageAccessPolicies(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]storage.TableAccessPolicy, 0, len(policies))
+	for _, policy := range policies {
+		output = append(output, storage.TableAccessPolicy{
+			ID:         policy.ID,
+			StartTime:  policy.Start,
+			ExpiryTime: policy.Expiry,
+			CanRead:    strings.Contains(policy.Permissions, "r"),
+			CanAppend:  strings.Contains(policy.Permissions, "a"),
+			CanUpdate:  strings.Contains(policy.Permissions, "u"),
+			CanDelete:  strings.Contains(policy.Permissions, "d"),
+		})
+	}
+
+	return output, nil
+}
+
+func flattenStorageTableAccessPolicies(input []storage.TableAccessPolicy) []interface{} {
+	policies := make([]storageAccessPolicy, 0, len(input))
+	for _, policy := range input {
+		policies = append(policies, storageAccessPolicy{
+			ID:          policy.ID,
+			Start:       policy.StartTime,
+			Expiry:      policy.ExpiryTime,
+			Permissions: storageAccessPolicyPermissions("raud", policy.CanRead, policy.CanAppend, policy.CanUpdate, policy.CanDelete),
+		})
+	}
+
+	return flattenStorageAccessPolicies(policies)
+}
@@ -0,0 +1,398 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/satori/uuid"
+)
+
+var storageTableEntityIDPathRegex = regexp.MustCompile(`^([^(/]+)\(PartitionKey='(.*)',\s*RowKey='(.*)'\)$`)
+
+func resourceArmStorageTableEntity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageTableEntityCreate,
+		Read:   resourceArmStorageTableEntityRead,
+		Update: resourceArmStorageTableEntityUpdate,
+		Delete: resourceArmStorageTableEntityDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmStorageTableEntityImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableName,
+			},
+			"resource_group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAzurermResourceGroupNameDiffSuppress,
+			},
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"partition_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableEntityKey,
+			},
+			"row_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableEntityKey,
+			},
+			"entity": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				DiffSuppressFunc: storageTableEntityDoubleDiffSuppress,
+			},
+			// the Edm type of each property in `entity`, properties which aren't listed are strings
+			"entity_types": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateArmStorageTableEntityTypes,
+			},
+		},
+	}
+}
+
+func validateArmStorageTableEntityTypes(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(map[string]interface{})
+
+	for key, edmType := range value {
+		_, errs := validation.StringInSlice([]string{
+			"Edm.Boolean",
+			"Edm.DateTime",
+			"Edm.Double",
+			"Edm.Guid",
+			"Edm.Int32",
+			"Edm.Int64",
+			"Edm.String",
+		}, false)(edmType, fmt.Sprintf("%s.%s", k, key))
+		errors = append(errors, errs...)
+	}
+
+	return
+}
+
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/understanding-the-table-service-data-model#characters-disallowed-in-key-fields
+func validateArmStorageTableEntityKey(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if regexp.MustCompile(`[/\\#?\x00-\x1f\x7f-\x9f]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot contain the characters `/`, `\\`, `#`, `?` or control characters: %q", k, value))
+	}
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 1KB: %q", k, value))
+	}
+	return
+}
+
+func resourceArmStorageTableEntityCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	tableName := d.Get("table_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+
+	properties, err := expandStorageTableEntityProperties(d.Get("entity").(map[string]interface{}), d.Get("entity_types").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+	entity.Properties = properties
+
+	log.Printf("[INFO] Inserting Entity (Partition Key %q / Row Key %q) into Table %q (Storage Account %q)", partitionKey, rowKey, tableName, storageAccountName)
+	if err := entity.Insert(storage.EmptyPayload, &storage.EntityOptions{}); err != nil {
+		if storageErr, ok := err.(storage.AzureStorageServiceError); ok && storageErr.StatusCode == http.StatusConflict {
+			return fmt.Errorf("An Entity with Partition Key %q and Row Key %q already exists in Table %q - to be managed via Terraform this resource needs to be imported into the State.", partitionKey, rowKey, tableName)
+		}
+		return fmt.Errorf("Error inserting Entity (Partition Key %q / Row Key %q) into Table %q (Storage Account %q): %s", partitionKey, rowKey, tableName, storageAccountName, err)
+	}
+
+	d.SetId(fmt.Sprintf("https://%s.table.%s/%s(PartitionKey='%s',RowKey='%s')",
+		storageAccountName, armClient.environment.StorageEndpointSuffix, tableName, partitionKey, rowKey))
+
+	return resourceArmStorageTableEntityRead(d, meta)
+}
+
+func resourceArmStorageTableEntityUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	tableName := d.Get("table_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+
+	properties, err := expandStorageTableEntityProperties(d.Get("entity").(map[string]interface{}), d.Get("entity_types").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+	entity.Properties = properties
+
+	// replacing (rather than merging) ensures properties removed from the configuration are removed from the Entity
+	log.Printf("[INFO] Replacing Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q)", partitionKey, rowKey, tableName, storageAccountName)
+	if err := entity.InsertOrReplace(&storage.EntityOptions{}); err != nil {
+		return fmt.Errorf("Error replacing Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q): %s", partitionKey, rowKey, tableName, storageAccountName, err)
+	}
+
+	return resourceArmStorageTableEntityRead(d, meta)
+}
+
+func resourceArmStorageTableEntityRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing Entity %q from state", storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tableName := d.Get("table_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+
+	entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+
+	// types aren't returned without metadata, they're instead taken from `entity_types`
+	if err := entity.Get(60, storage.NoMetadata, &storage.GetEntityOptions{}); err != nil {
+		if storageErr, ok := err.(storage.AzureStorageServiceError); ok && storageErr.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Entity (Partition Key %q / Row Key %q) no longer exists in Table %q, removing from state...", partitionKey, rowKey, tableName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q): %s", partitionKey, rowKey, tableName, storageAccountName, err)
+	}
+
+	flattened := flattenStorageTableEntityProperties(entity.Properties, d.Get("entity_types").(map[string]interface{}))
+	if err := d.Set("entity", flattened); err != nil {
+		return fmt.Errorf("Error flattening `entity`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageTableEntityDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the Entity won't exist", storageAccountName)
+		return nil
+	}
+
+	tableName := d.Get("table_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+
+	entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+	if err := entity.Delete(true, &storage.EntityOptions{}); err != nil {
+		if storageErr, ok := err.(storage.AzureStorageServiceError); ok && storageErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q): %s", partitionKey, rowKey, tableName, storageAccountName, err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageTableEntityImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := parseStorageDataPlaneID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	matches := storageTableEntityIDPathRegex.FindStringSubmatch(id.Path)
+	if id.Service != "table" || matches == nil {
+		return nil, fmt.Errorf("Expected the ID %q to be in the format `https://{account}.table.{suffix}/{table}(PartitionKey='{partitionKey}',RowKey='{rowKey}')`", d.Id())
+	}
+
+	resourceGroup, err := meta.(*ArmClient).findResourceGroupForStorageAccount(id.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("storage_account_name", id.AccountName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("table_name", matches[1])
+	d.Set("partition_key", matches[2])
+	d.Set("row_key", matches[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandStorageTableEntityProperties converts the string values in the configuration into the Go types which the
+// Storage SDK serializes as each Edm type - the SDK adds the `@odata.type` annotations where these are required.
+func expandStorageTableEntityProperties(input map[string]interface{}, types map[string]interface{}) (map[string]interface{}, error) {
+	for k := range types {
+		if _, ok := input[k]; !ok {
+			return nil, fmt.Errorf("`entity_types` contains the property %q which isn't defined in `entity`", k)
+		}
+	}
+
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		value := v.(string)
+
+		edmType := "Edm.String"
+		if t, ok := types[k]; ok {
+			edmType = t.(string)
+		}
+
+		switch edmType {
+		case "Edm.Boolean":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing property %q as an %s: %s", k, edmType, err)
+			}
+			output[k] = b
+		case "Edm.DateTime":
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing property %q as an %s: %s", k, edmType, err)
+			}
+			output[k] = t.UTC()
+		case "Edm.Double":
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing property %q as an %s: %s", k, edmType, err)
+			}
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, fmt.Errorf("Error parsing property %q as an %s: %q isn't a finite number", k, edmType, value)
+			}
+			output[k] = storageTableEntityDouble(f)
+		case "Edm.Guid":
+			u, err := uuid.FromString(value)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing property %q as an %s: %s", k, edmType, err)
+			}
+			output[k] = u
+		case "Edm.Int32":
+			i, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing property %q as an %s: %s", k, edmType, err)
+			}
+			output[k] = int32(i)
+		case "Edm.Int64":
+			i, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing property %q as an %s: %s", k, edmType, err)
+			}
+			output[k] = i
+		default:
+			output[k] = value
+		}
+	}
+
+	return output, nil
+}
+
+// storageTableEntityDouble is always serialized with a decimal point, so that whole numbers are inferred as an
+// `Edm.Double` rather than an `Edm.Int32` - since the vendored SDK rejects an `Edm.Double` type annotation.
+type storageTableEntityDouble float64
+
+func (v storageTableEntityDouble) MarshalJSON() ([]byte, error) {
+	value := strconv.FormatFloat(float64(v), 'f', -1, 64)
+	if !strings.Contains(value, ".") {
+		value += ".0"
+	}
+	return []byte(value), nil
+}
+
+// whole-number Doubles are returned without a decimal point, so equivalent values (e.g. `1` and `1.0`) are suppressed
+func storageTableEntityDoubleDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	name := strings.TrimPrefix(k, "entity.")
+	if t, ok := d.Get("entity_types").(map[string]interface{})[name]; !ok || t.(string) != "Edm.Double" {
+		return false
+	}
+
+	oldValue, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+	newValue, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+
+	return oldValue == newValue
+}
+
+// flattenStorageTableEntityProperties converts the values returned from the API (without metadata) back into strings,
+// normalizing date-times so that they match the format accepted in the configuration.
+func flattenStorageTableEntityProperties(input map[string]interface{}, types map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		switch value := v.(type) {
+		case bool:
+			output[k] = strconv.FormatBool(value)
+		case float64:
+			output[k] = strconv.FormatFloat(value, 'f', -1, 64)
+		case string:
+			output[k] = value
+			if t, ok := types[k]; ok && t.(string) == "Edm.DateTime" {
+				if parsed, err := time.Parse(time.RFC3339Nano, value); err == nil {
+					output[k] = parsed.UTC().Format(time.RFC3339Nano)
+				}
+			}
+		default:
+			output[k] = fmt.Sprintf("%v", value)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,460 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/satori/uuid"
+)
+
+func TestAccAzureRMStorageTableEntity_basic(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageTableEntity_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entity.hello", "world"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageTableEntity_typed(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageTableEntity_basic(ri, rs, location)
+	postConfig := testAccAzureRMStorageTableEntity_typed(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "1"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "6"),
+					resource.TestCheckResourceAttr(resourceName, "entity.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "entity.count", "42"),
+					resource.TestCheckResourceAttr(resourceName, "entity.big", "9223372036854775807"),
+					resource.TestCheckResourceAttr(resourceName, "entity.ratio", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "entity.created", "2018-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr(resourceName, "entity.id", "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageTableEntityExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		exists, err := testCheckAzureRMStorageTableEntityExistsInAccount(rs)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) does not exist", rs.Primary.Attributes["partition_key"], rs.Primary.Attributes["row_key"])
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageTableEntityDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_table_entity" {
+			continue
+		}
+
+		exists, err := testCheckAzureRMStorageTableEntityExistsInAccount(rs)
+		if err != nil {
+			// If we can't get keys then the entity can't exist
+			return nil
+		}
+		if exists {
+			return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) still exists", rs.Primary.Attributes["partition_key"], rs.Primary.Attributes["row_key"])
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMStorageTableEntityExistsInAccount(rs *terraform.ResourceState) (bool, error) {
+	tableName := rs.Primary.Attributes["table_name"]
+	partitionKey := rs.Primary.Attributes["partition_key"]
+	rowKey := rs.Primary.Attributes["row_key"]
+	storageAccountName := rs.Primary.Attributes["storage_account_name"]
+	resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+
+	armClient := testAccProvider.Meta().(*ArmClient)
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return false, err
+	}
+	if !accountExists {
+		return false, nil
+	}
+
+	entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+	if err := entity.Get(60, storage.NoMetadata, &storage.GetEntityOptions{}); err != nil {
+		if storageErr, ok := err.(storage.AzureStorageServiceError); ok && storageErr.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func TestExpandStorageTableEntityProperties(t *testing.T) {
+	input := map[string]interface{}{
+		"name":    "example",
+		"enabled": "true",
+		"count":   "42",
+		"big":     "9223372036854775807",
+		"ratio":   "1.5",
+		"created": "2018-01-02T04:04:05+01:00",
+		"id":      "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+	}
+	types := map[string]interface{}{
+		"enabled": "Edm.Boolean",
+		"count":   "Edm.Int32",
+		"big":     "Edm.Int64",
+		"ratio":   "Edm.Double",
+		"created": "Edm.DateTime",
+		"id":      "Edm.Guid",
+	}
+
+	expected := map[string]interface{}{
+		"name":    "example",
+		"enabled": true,
+		"count":   int32(42),
+		"big":     int64(9223372036854775807),
+		"ratio":   storageTableEntityDouble(1.5),
+		"created": time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC),
+		"id":      uuid.FromStringOrNil("6d74bdd2-9f84-11e5-9bd9-7831c1c4c038"),
+	}
+
+	actual, err := expandStorageTableEntityProperties(input, types)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestExpandStorageTableEntityPropertiesInvalid(t *testing.T) {
+	cases := []struct {
+		Input map[string]interface{}
+		Types map[string]interface{}
+	}{
+		{
+			Input: map[string]interface{}{"count": "many"},
+			Types: map[string]interface{}{"count": "Edm.Int32"},
+		},
+		{
+			Input: map[string]interface{}{"count": "9223372036854775807"},
+			Types: map[string]interface{}{"count": "Edm.Int32"},
+		},
+		{
+			Input: map[string]interface{}{"created": "yesterday"},
+			Types: map[string]interface{}{"created": "Edm.DateTime"},
+		},
+		{
+			Input: map[string]interface{}{"ratio": "NaN"},
+			Types: map[string]interface{}{"ratio": "Edm.Double"},
+		},
+		{
+			// a type for a property which isn't defined
+			Input: map[string]interface{}{"name": "example"},
+			Types: map[string]interface{}{"count": "Edm.Int32"},
+		},
+	}
+
+	for _, tc := range cases {
+		if _, err := expandStorageTableEntityProperties(tc.Input, tc.Types); err == nil {
+			t.Fatalf("Expected an error expanding %+v with the types %+v", tc.Input, tc.Types)
+		}
+	}
+}
+
+func TestFlattenStorageTableEntityProperties(t *testing.T) {
+	// the types returned from the API without metadata, as deserialized by the Storage SDK
+	input := map[string]interface{}{
+		"name":    "example",
+		"enabled": true,
+		"count":   float64(42),
+		"big":     "9223372036854775807",
+		"ratio":   float64(1.5),
+		"created": "2018-01-02T03:04:05.0000000Z",
+		"id":      "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+	}
+	types := map[string]interface{}{
+		"created": "Edm.DateTime",
+	}
+
+	expected := map[string]interface{}{
+		"name":    "example",
+		"enabled": "true",
+		"count":   "42",
+		"big":     "9223372036854775807",
+		"ratio":   "1.5",
+		"created": "2018-01-02T03:04:05Z",
+		"id":      "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+	}
+
+	actual := flattenStorageTableEntityProperties(input, types)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestStorageTableEntityPropertiesRoundTrip(t *testing.T) {
+	input := map[string]interface{}{
+		"name":    "example",
+		"enabled": "true",
+		"count":   "42",
+		"big":     "9223372036854775807",
+		"ratio":   "1.5",
+		"whole":   "2",
+		"created": "2018-01-02T03:04:05Z",
+		"id":      "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+	}
+	types := map[string]interface{}{
+		"enabled": "Edm.Boolean",
+		"count":   "Edm.Int32",
+		"big":     "Edm.Int64",
+		"ratio":   "Edm.Double",
+		"whole":   "Edm.Double",
+		"created": "Edm.DateTime",
+		"id":      "Edm.Guid",
+	}
+
+	properties, err := expandStorageTableEntityProperties(input, types)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// serialize the entity as the Storage SDK sends it, then deserialize it as it's returned without metadata
+	entity := storage.Entity{
+		PartitionKey: "partition1",
+		RowKey:       "row1",
+		Properties:   properties,
+	}
+	body, err := json.Marshal(&entity)
+	if err != nil {
+		t.Fatalf("Error serializing the entity: %s", err)
+	}
+	// whole-number Doubles must be sent with a decimal point, otherwise they're inferred as an Edm.Int32
+	if !strings.Contains(string(body), `"whole":2.0`) {
+		t.Fatalf("Expected the Edm.Double `whole` to be serialized with a decimal point: %s", string(body))
+	}
+
+	returned := make(map[string]interface{})
+	if err := json.Unmarshal(body, &returned); err != nil {
+		t.Fatalf("Error deserializing the entity: %s", err)
+	}
+	delete(returned, "PartitionKey")
+	delete(returned, "RowKey")
+	for k := range returned {
+		if strings.HasSuffix(k, storage.OdataTypeSuffix) {
+			delete(returned, k)
+		}
+	}
+
+	actual := flattenStorageTableEntityProperties(returned, types)
+	if !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestStorageTableEntityDoubleMarshalJSON(t *testing.T) {
+	cases := []struct {
+		Value    float64
+		Expected string
+	}{
+		{Value: 1, Expected: "1.0"},
+		{Value: -20, Expected: "-20.0"},
+		{Value: 1.5, Expected: "1.5"},
+		{Value: 0.000001, Expected: "0.000001"},
+	}
+
+	for _, tc := range cases {
+		actual, err := json.Marshal(storageTableEntityDouble(tc.Value))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(actual) != tc.Expected {
+			t.Fatalf("Expected %v to be serialized as %q but got %q", tc.Value, tc.Expected, string(actual))
+		}
+	}
+}
+
+func TestStorageTableEntityDoubleDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Key      string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{Key: "entity.ratio", Old: "1", New: "1.0", Suppress: true},
+		{Key: "entity.ratio", Old: "1.5", New: "1.50", Suppress: true},
+		{Key: "entity.ratio", Old: "1", New: "2.0", Suppress: false},
+		{Key: "entity.count", Old: "1", New: "1.0", Suppress: false},
+		{Key: "entity.%", Old: "2", New: "2.0", Suppress: false},
+	}
+
+	d := resourceArmStorageTableEntity().TestResourceData()
+	d.Set("entity_types", map[string]interface{}{
+		"ratio": "Edm.Double",
+		"count": "Edm.Int32",
+	})
+
+	for _, tc := range cases {
+		if actual := storageTableEntityDoubleDiffSuppress(tc.Key, tc.Old, tc.New, d); actual != tc.Suppress {
+			t.Fatalf("Expected the diff for %q from %q to %q to be suppressed: %t but got %t", tc.Key, tc.Old, tc.New, tc.Suppress, actual)
+		}
+	}
+}
+
+func TestValidateArmStorageTableEntityKey(t *testing.T) {
+	validKeys := []string{
+		"",
+		"partition1",
+		"with spaces",
+		"2018-01-02",
+	}
+	for _, v := range validKeys {
+		_, errors := validateArmStorageTableEntityKey(v, "partition_key")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Storage Table Entity Key: %q", v, errors)
+		}
+	}
+
+	invalidKeys := []string{
+		"forward/slash",
+		"back\\slash",
+		"hash#",
+		"question?",
+		"tab\t",
+		strings.Repeat("a", 1025),
+	}
+	for _, v := range invalidKeys {
+		_, errors := validateArmStorageTableEntityKey(v, "partition_key")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Storage Table Entity Key", v)
+		}
+	}
+}
+
+func testAccAzureRMStorageTableEntity_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestacc%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, rInt, location, rString, rInt)
+}
+
+func testAccAzureRMStorageTableEntity_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  table_name           = "${azurerm_storage_table.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  partition_key        = "partition1"
+  row_key              = "row1"
+
+  entity {
+    hello = "world"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageTableEntity_typed(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  table_name           = "${azurerm_storage_table.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  partition_key        = "partition1"
+  row_key              = "row1"
+
+  entity {
+    enabled = "true"
+    count   = "42"
+    big     = "9223372036854775807"
+    ratio   = "1.5"
+    whole   = "2.0"
+    created = "2018-01-02T03:04:05Z"
+    id      = "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038"
+  }
+
+  entity_types {
+    enabled = "Edm.Boolean"
+    count   = "Edm.Int32"
+    big     = "Edm.Int64"
+    ratio   = "Edm.Double"
+    whole   = "Edm.Double"
+    created = "Edm.DateTime"
+    id      = "Edm.Guid"
+  }
+}
+`, template)
+}
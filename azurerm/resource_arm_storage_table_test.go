@@ -58,6 +58,52 @@ func TestAccAzureRMStorageTable_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageTable_accessPolicy(t *testing.T) {
+	var table storage.Table
+
+	resourceName := "azurerm_storage_table.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	preConfig := testAccAzureRMStorageTable_accessPolicy(ri, rs, location, "r")
+	postConfig := testAccAzureRMStorageTable_accessPolicy(ri, rs, location, "raud")
+	removedConfig := testAccAzureRMStorageTable_basic(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.id", "policy1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.start", "2018-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.expiry", "2028-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "r"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.permissions", "raud"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageTableExists(name string, t *storage.Table) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -243,3 +289,36 @@ resource "azurerm_storage_table" "test" {
 }
 `, rInt, location, rString, rInt)
 }
+
+func testAccAzureRMStorageTable_accessPolicy(rInt int, rString string, location string, permissions string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestacc%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  account_type        = "Standard_LRS"
+
+  tags {
+    environment = "staging"
+  }
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+
+  access_policy {
+    id          = "policy1"
+    start       = "2018-01-01T00:00:00Z"
+    expiry      = "2028-01-01T00:00:00Z"
+    permissions = "%s"
+  }
+}
+`, rInt, location, rString, rInt, permissions)
+}
@@ -9,8 +9,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var subnetResourceName = "azurerm_subnet"
-
 func resourceArmSubnet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSubnetCreate,
@@ -79,8 +77,9 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	resGroup := d.Get("resource_group_name").(string)
 	addressPrefix := d.Get("address_prefix").(string)
 
-	azureRMLockByName(vnetName, virtualNetworkResourceName)
-	defer azureRMUnlockByName(vnetName, virtualNetworkResourceName)
+	idsToLock := []string{
+		networkResourceID(client.subscriptionId, resGroup, "virtualNetworks", vnetName),
+	}
 
 	properties := network.SubnetPropertiesFormat{
 		AddressPrefix: &addressPrefix,
@@ -92,13 +91,7 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 			ID: &nsgId,
 		}
 
-		networkSecurityGroupName, err := parseNetworkSecurityGroupName(nsgId)
-		if err != nil {
-			return err
-		}
-
-		azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-		defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		idsToLock = append(idsToLock, nsgId)
 	}
 
 	if v, ok := d.GetOk("route_table_id"); ok {
@@ -107,15 +100,12 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 			ID: &rtId,
 		}
 
-		routeTableName, err := parseRouteTableName(rtId)
-		if err != nil {
-			return err
-		}
-
-		azureRMLockByName(routeTableName, routeTableResourceName)
-		defer azureRMUnlockByName(routeTableName, routeTableResourceName)
+		idsToLock = append(idsToLock, rtId)
 	}
 
+	azureRMLockMultipleByID(idsToLock)
+	defer azureRMUnlockMultipleByID(idsToLock)
+
 	subnet := network.Subnet{
 		Name: &name,
 		SubnetPropertiesFormat: &properties,
@@ -164,23 +154,35 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", name)
 	d.Set("resource_group_name", resGroup)
 	d.Set("virtual_network_name", vnetName)
-	d.Set("address_prefix", resp.SubnetPropertiesFormat.AddressPrefix)
 
-	if resp.SubnetPropertiesFormat.NetworkSecurityGroup != nil {
-		d.Set("network_security_group_id", resp.SubnetPropertiesFormat.NetworkSecurityGroup.ID)
+	return flattenAndSetSubnet(d, resp)
+}
+
+// flattenAndSetSubnet sets the properties of the Subnet which are common to both the
+// resource and the data source
+func flattenAndSetSubnet(d *schema.ResourceData, resp network.Subnet) error {
+	props := resp.SubnetPropertiesFormat
+	if props == nil {
+		return nil
+	}
+
+	d.Set("address_prefix", props.AddressPrefix)
+
+	if props.NetworkSecurityGroup != nil {
+		d.Set("network_security_group_id", props.NetworkSecurityGroup.ID)
 	} else {
 		d.Set("network_security_group_id", "")
 	}
 
-	if resp.SubnetPropertiesFormat.RouteTable != nil {
-		d.Set("route_table_id", resp.SubnetPropertiesFormat.RouteTable.ID)
+	if props.RouteTable != nil {
+		d.Set("route_table_id", props.RouteTable.ID)
 	} else {
 		d.Set("route_table_id", "")
 	}
 
-	if resp.SubnetPropertiesFormat.IPConfigurations != nil {
-		ips := make([]string, 0, len(*resp.SubnetPropertiesFormat.IPConfigurations))
-		for _, ip := range *resp.SubnetPropertiesFormat.IPConfigurations {
+	if props.IPConfigurations != nil {
+		ips := make([]string, 0, len(*props.IPConfigurations))
+		for _, ip := range *props.IPConfigurations {
 			ips = append(ips, *ip.ID)
 		}
 
@@ -205,33 +207,21 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	name := id.Path["subnets"]
 	vnetName := id.Path["virtualNetworks"]
 
-	if v, ok := d.GetOk("network_security_group_id"); ok {
-		networkSecurityGroupId := v.(string)
-		networkSecurityGroupName, err := parseNetworkSecurityGroupName(networkSecurityGroupId)
-		if err != nil {
-			return err
-		}
+	idsToLock := []string{
+		d.Id(),
+		networkResourceID(id.SubscriptionID, resGroup, "virtualNetworks", vnetName),
+	}
 
-		azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-		defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+	if v, ok := d.GetOk("network_security_group_id"); ok {
+		idsToLock = append(idsToLock, v.(string))
 	}
 
 	if v, ok := d.GetOk("route_table_id"); ok {
-		rtId := v.(string)
-		routeTableName, err := parseRouteTableName(rtId)
-		if err != nil {
-			return err
-		}
-
-		azureRMLockByName(routeTableName, routeTableResourceName)
-		defer azureRMUnlockByName(routeTableName, routeTableResourceName)
+		idsToLock = append(idsToLock, v.(string))
 	}
 
-	azureRMLockByName(vnetName, virtualNetworkResourceName)
-	defer azureRMUnlockByName(vnetName, virtualNetworkResourceName)
-
-	azureRMLockByName(name, subnetResourceName)
-	defer azureRMUnlockByName(name, subnetResourceName)
+	azureRMLockMultipleByID(idsToLock)
+	defer azureRMUnlockMultipleByID(idsToLock)
 
 	_, error := subnetClient.Delete(resGroup, vnetName, name, make(chan struct{}))
 	err = <-error
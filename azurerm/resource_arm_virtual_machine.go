@@ -529,12 +529,28 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 		storageProfile.ImageReference = imageRef
 	}
 
-	if _, ok := d.GetOk("storage_data_disk"); ok {
+	// the Virtual Machine is locked since Data Disks can be attached/detached by other resources concurrently
+	virtualMachineId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", client.subscriptionId, resGroup, name)
+	azureRMLockByID(virtualMachineId)
+	defer azureRMUnlockByID(virtualMachineId)
+
+	if inlineChildrenAreAuthoritative(d, "storage_data_disk") {
+		// an empty list is sent when every block is removed, so the Data Disks are detached
 		dataDisks, err := expandAzureRmVirtualMachineDataDisk(d)
 		if err != nil {
 			return err
 		}
 		storageProfile.DataDisks = &dataDisks
+	} else {
+		// the Data Disks aren't managed inline, so retain those which are attached (e.g. from `azurerm_virtual_machine_data_disk_attachment`)
+		existing, err := vmClient.Get(resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil {
+			storageProfile.DataDisks = props.StorageProfile.DataDisks
+		}
 	}
 
 	networkProfile := expandAzureRmVirtualMachineNetworkProfile(d)
@@ -645,6 +661,10 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("availability_set_id", strings.ToLower(*resp.VirtualMachineProperties.AvailabilitySet.ID))
 	}
 
+	// Data Disks are only read back when they're managed inline (or the Virtual Machine is being imported, where
+	// `vm_size` isn't yet set) - otherwise they may be managed via `azurerm_virtual_machine_data_disk_attachment`
+	dataDisksManagedInline := d.IsNewResource() || len(d.Get("storage_data_disk").([]interface{})) > 0 || d.Get("vm_size").(string) == ""
+
 	d.Set("vm_size", resp.VirtualMachineProperties.HardwareProfile.VMSize)
 
 	if resp.VirtualMachineProperties.StorageProfile.ImageReference != nil {
@@ -657,7 +677,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("[DEBUG] Error setting Virtual Machine Storage OS Disk error: %#v", err)
 	}
 
-	if resp.VirtualMachineProperties.StorageProfile.DataDisks != nil {
+	if resp.VirtualMachineProperties.StorageProfile.DataDisks != nil && dataDisksManagedInline {
 		if err := d.Set("storage_data_disk", flattenAzureRmVirtualMachineDataDisk(resp.VirtualMachineProperties.StorageProfile.DataDisks)); err != nil {
 			return fmt.Errorf("[DEBUG] Error setting Virtual Machine Storage Data Disks error: %#v", err)
 		}
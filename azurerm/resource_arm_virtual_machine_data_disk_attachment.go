@@ -0,0 +1,269 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// The Data Disk Attachment has an ID in the format `{virtualMachineId}/dataDisks/{diskName}`, since a
+// Managed Disk can only be attached to a single Virtual Machine
+
+func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Read:   resourceArmVirtualMachineDataDiskAttachmentRead,
+		Update: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Delete: resourceArmVirtualMachineDataDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"managed_disk_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"virtual_machine_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"lun": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"caching": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.None),
+					string(compute.ReadOnly),
+					string(compute.ReadWrite),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+
+	virtualMachineId := d.Get("virtual_machine_id").(string)
+	vmId, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return err
+	}
+	resGroup := vmId.ResourceGroup
+	virtualMachineName := vmId.Path["virtualMachines"]
+
+	managedDiskId := d.Get("managed_disk_id").(string)
+	diskId, err := parseAzureResourceID(managedDiskId)
+	if err != nil {
+		return err
+	}
+	diskName := diskId.Path["disks"]
+	if diskName == "" {
+		return fmt.Errorf("Error: `managed_disk_id` %q must be the ID of a Managed Disk", managedDiskId)
+	}
+
+	lun := int32(d.Get("lun").(int))
+	caching := d.Get("caching").(string)
+
+	azureRMLockByID(virtualMachineId)
+	defer azureRMUnlockByID(virtualMachineId)
+
+	virtualMachine, err := client.Get(resGroup, virtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			return fmt.Errorf("Error: Virtual Machine %q (Resource Group %q) was not found", virtualMachineName, resGroup)
+		}
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resGroup, err)
+	}
+
+	if virtualMachine.VirtualMachineProperties == nil || virtualMachine.VirtualMachineProperties.StorageProfile == nil {
+		return fmt.Errorf("Error: `properties.storageProfile` was nil for Virtual Machine %q (Resource Group %q)", virtualMachineName, resGroup)
+	}
+
+	storageProfile := virtualMachine.VirtualMachineProperties.StorageProfile
+	disks := make([]compute.DataDisk, 0)
+	if storageProfile.DataDisks != nil {
+		disks = *storageProfile.DataDisks
+	}
+
+	expandedDisk := compute.DataDisk{
+		Name:         utils.String(diskName),
+		Caching:      compute.CachingTypes(caching),
+		CreateOption: compute.Attach,
+		Lun:          utils.Int32(lun),
+		ManagedDisk: &compute.ManagedDiskParameters{
+			ID: utils.String(managedDiskId),
+		},
+	}
+
+	if d.IsNewResource() {
+		for _, disk := range disks {
+			if disk.Name != nil && strings.EqualFold(*disk.Name, diskName) {
+				return fmt.Errorf("A Data Disk named %q is already attached to Virtual Machine %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information.", diskName, virtualMachineName, resGroup, "azurerm_virtual_machine_data_disk_attachment")
+			}
+
+			if disk.Lun != nil && *disk.Lun == lun {
+				return fmt.Errorf("Error: LUN %d is already in use on Virtual Machine %q (Resource Group %q)", lun, virtualMachineName, resGroup)
+			}
+		}
+
+		disks = append(disks, expandedDisk)
+	} else {
+		found := false
+		for i, disk := range disks {
+			if disk.Name != nil && strings.EqualFold(*disk.Name, diskName) {
+				disks[i] = expandedDisk
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Error: Data Disk %q was not found on Virtual Machine %q (Resource Group %q)", diskName, virtualMachineName, resGroup)
+		}
+	}
+
+	storageProfile.DataDisks = &disks
+
+	if err := updateVirtualMachineForDataDiskAttachment(client, resGroup, virtualMachineName, virtualMachine); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/dataDisks/%s", virtualMachineId, diskName))
+
+	return resourceArmVirtualMachineDataDiskAttachmentRead(d, meta)
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	virtualMachineName := id.Path["virtualMachines"]
+	diskName := id.Path["dataDisks"]
+
+	virtualMachine, err := client.Get(resGroup, virtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			log.Printf("[INFO] Virtual Machine %q (Resource Group %q) was not found - removing Data Disk Attachment from state", virtualMachineName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resGroup, err)
+	}
+
+	disk := findVirtualMachineDataDiskByName(virtualMachine, diskName)
+	if disk == nil {
+		log.Printf("[INFO] Data Disk %q was not found on Virtual Machine %q (Resource Group %q) - removing from state", diskName, virtualMachineName, resGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("virtual_machine_id", virtualMachine.ID)
+	d.Set("caching", string(disk.Caching))
+	if lun := disk.Lun; lun != nil {
+		d.Set("lun", int(*lun))
+	}
+	if managedDisk := disk.ManagedDisk; managedDisk != nil {
+		d.Set("managed_disk_id", managedDisk.ID)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	virtualMachineName := id.Path["virtualMachines"]
+	diskName := id.Path["dataDisks"]
+
+	virtualMachineId := strings.TrimSuffix(d.Id(), fmt.Sprintf("/dataDisks/%s", diskName))
+	azureRMLockByID(virtualMachineId)
+	defer azureRMUnlockByID(virtualMachineId)
+
+	virtualMachine, err := client.Get(resGroup, virtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resGroup, err)
+	}
+
+	if findVirtualMachineDataDiskByName(virtualMachine, diskName) == nil {
+		return nil
+	}
+
+	storageProfile := virtualMachine.VirtualMachineProperties.StorageProfile
+	disks := make([]compute.DataDisk, 0)
+	for _, disk := range *storageProfile.DataDisks {
+		if disk.Name != nil && strings.EqualFold(*disk.Name, diskName) {
+			continue
+		}
+		disks = append(disks, disk)
+	}
+	storageProfile.DataDisks = &disks
+
+	if err := updateVirtualMachineForDataDiskAttachment(client, resGroup, virtualMachineName, virtualMachine); err != nil {
+		return fmt.Errorf("Error detaching Data Disk %q: %+v", diskName, err)
+	}
+
+	return nil
+}
+
+// updateVirtualMachineForDataDiskAttachment sends the retrieved Virtual Machine back to the API with the updated
+// Data Disks, which must be called whilst holding a lock on the Virtual Machine
+func updateVirtualMachineForDataDiskAttachment(client compute.VirtualMachinesClient, resGroup string, name string, virtualMachine compute.VirtualMachine) error {
+	// the Extensions are returned by the API but are managed separately, and can't be sent in an update
+	virtualMachine.Resources = nil
+
+	_, error := client.CreateOrUpdate(resGroup, name, virtualMachine, make(chan struct{}))
+	err := <-error
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func findVirtualMachineDataDiskByName(virtualMachine compute.VirtualMachine, name string) *compute.DataDisk {
+	props := virtualMachine.VirtualMachineProperties
+	if props == nil || props.StorageProfile == nil || props.StorageProfile.DataDisks == nil {
+		return nil
+	}
+
+	disks := *props.StorageProfile.DataDisks
+	for i := range disks {
+		if disks[i].Name != nil && strings.EqualFold(*disks[i].Name, name) {
+			return &disks[i]
+		}
+	}
+
+	return nil
+}
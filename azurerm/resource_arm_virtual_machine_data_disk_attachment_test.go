@@ -0,0 +1,340 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, testLocation(), "None", 10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lun", "0"),
+					resource.TestCheckResourceAttr(resourceName, "caching", "None"),
+				),
+			},
+			{
+				// the attached Data Disk shouldn't be read into the Virtual Machine's state after a refresh
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine.test", "storage_data_disk.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_updateCaching(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location, "None", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "caching", "None"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location, "ReadOnly", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "caching", "ReadOnly"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_resizeAttachedDisk(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location, "None", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_managed_disk.test", "disk_size_gb", "10"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location, "None", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_managed_disk.test", "disk_size_gb", "20"),
+					testCheckAzureRMVirtualMachineDataDiskAttachmentVirtualMachineIsRunning("azurerm_virtual_machine.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_multipleDisks(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineDataDiskAttachment_multipleDisks(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists("azurerm_virtual_machine_data_disk_attachment.first"),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_data_disk_attachment.first", "lun", "10"),
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists("azurerm_virtual_machine_data_disk_attachment.second"),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_data_disk_attachment.second", "lun", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineDataDiskAttachmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		exists, err := testCheckAzureRMVirtualMachineDataDiskAttachmentExistsOnVirtualMachine(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: Data Disk Attachment %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testCheckAzureRMVirtualMachineDataDiskAttachmentVirtualMachineIsRunning ensures the Virtual Machine was started
+// again after being deallocated to resize an attached Managed Disk
+func testCheckAzureRMVirtualMachineDataDiskAttachmentVirtualMachineIsRunning(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		vmName := rs.Primary.Attributes["name"]
+		resGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).vmClient
+		resp, err := client.Get(resGroup, vmName, compute.InstanceView)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vmClient: %+v", err)
+		}
+
+		if props := resp.VirtualMachineProperties; props != nil && props.InstanceView != nil && props.InstanceView.Statuses != nil {
+			for _, status := range *props.InstanceView.Statuses {
+				if status.Code != nil && strings.EqualFold(*status.Code, "PowerState/running") {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: Virtual Machine %q (Resource Group %q) is not running", vmName, resGroup)
+	}
+}
+
+func testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_data_disk_attachment" {
+			continue
+		}
+
+		exists, err := testCheckAzureRMVirtualMachineDataDiskAttachmentExistsOnVirtualMachine(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Bad: Data Disk Attachment %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMVirtualMachineDataDiskAttachmentExistsOnVirtualMachine(attachmentId string) (bool, error) {
+	client := testAccProvider.Meta().(*ArmClient).vmClient
+
+	id, err := parseAzureResourceID(attachmentId)
+	if err != nil {
+		return false, err
+	}
+	resGroup := id.ResourceGroup
+	virtualMachineName := id.Path["virtualMachines"]
+	diskName := id.Path["dataDisks"]
+
+	resp, err := client.Get(resGroup, virtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Bad: Get on vmClient: %+v", err)
+	}
+
+	return findVirtualMachineDataDiskByName(resp, diskName) != nil, nil
+}
+
+func testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_D1_v2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVirtualMachineDataDiskAttachment_basic(rInt int, location string, caching string, diskSizeGB int) string {
+	template := testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = %d
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = "${azurerm_managed_disk.test.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = 0
+  caching            = "%s"
+}
+`, template, rInt, diskSizeGB, caching)
+}
+
+func testAccAzureRMVirtualMachineDataDiskAttachment_multipleDisks(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "first" {
+  name                 = "acctestd1-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "first" {
+  managed_disk_id    = "${azurerm_managed_disk.first.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = 10
+  caching            = "None"
+}
+
+resource "azurerm_managed_disk" "second" {
+  name                 = "acctestd2-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "second" {
+  managed_disk_id    = "${azurerm_managed_disk.second.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = 20
+  caching            = "ReadOnly"
+}
+`, template, rInt, rInt)
+}
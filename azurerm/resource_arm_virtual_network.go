@@ -12,8 +12,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var virtualNetworkResourceName = "azurerm_virtual_network"
-
 func resourceArmVirtualNetwork() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualNetworkCreate,
@@ -93,6 +91,16 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
+
+	nsgIds, err := expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupIDs(d)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing Network Security Group ID's: %+v", err)
+	}
+
+	idsToLock := append(nsgIds, networkResourceID(client.subscriptionId, resGroup, "virtualNetworks", name))
+	azureRMLockMultipleByID(idsToLock)
+	defer azureRMUnlockMultipleByID(idsToLock)
+
 	vnetProperties, vnetPropsErr := getVirtualNetworkProperties(d, meta)
 	if vnetPropsErr != nil {
 		return vnetPropsErr
@@ -105,23 +113,8 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 		Tags: expandTags(tags),
 	}
 
-	networkSecurityGroupNames := make([]string, 0)
-	for _, subnet := range *vnet.VirtualNetworkPropertiesFormat.Subnets {
-		if subnet.NetworkSecurityGroup != nil {
-			nsgName, err := parseNetworkSecurityGroupName(*subnet.NetworkSecurityGroup.ID)
-			if err != nil {
-				return err
-			}
-
-			networkSecurityGroupNames = append(networkSecurityGroupNames, nsgName)
-		}
-	}
-
-	azureRMLockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
-	defer azureRMUnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
-
 	_, error := vnetClient.CreateOrUpdate(resGroup, name, vnet, make(chan struct{}))
-	err := <-error
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -158,19 +151,45 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error making Read request on Azure virtual network %s: %s", name, err)
 	}
 
-	vnet := *resp.VirtualNetworkPropertiesFormat
-
-	// update appropriate values
 	d.Set("resource_group_name", resGroup)
 	d.Set("name", resp.Name)
+	flattenAndSetVirtualNetwork(d, resp)
+
+	return nil
+}
+
+// flattenAndSetVirtualNetwork sets the properties of the Virtual Network which are common
+// to both the resource and the data source
+func flattenAndSetVirtualNetwork(d *schema.ResourceData, resp network.VirtualNetwork) {
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	d.Set("address_space", vnet.AddressSpace.AddressPrefixes)
 
+	if vnet := resp.VirtualNetworkPropertiesFormat; vnet != nil {
+		if vnet.AddressSpace != nil {
+			d.Set("address_space", vnet.AddressSpace.AddressPrefixes)
+		}
+
+		if vnet.Subnets != nil {
+			d.Set("subnet", flattenVirtualNetworkSubnets(vnet.Subnets))
+		}
+
+		if vnet.DhcpOptions != nil && vnet.DhcpOptions.DNSServers != nil {
+			dnses := []string{}
+			for _, dns := range *vnet.DhcpOptions.DNSServers {
+				dnses = append(dnses, dns)
+			}
+			d.Set("dns_servers", dnses)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+}
+
+func flattenVirtualNetworkSubnets(input *[]network.Subnet) *schema.Set {
 	subnets := &schema.Set{
 		F: resourceAzureSubnetHash,
 	}
 
-	for _, subnet := range *vnet.Subnets {
+	for _, subnet := range *input {
 		s := map[string]interface{}{}
 
 		s["name"] = *subnet.Name
@@ -181,19 +200,8 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 
 		subnets.Add(s)
 	}
-	d.Set("subnet", subnets)
 
-	if vnet.DhcpOptions != nil && vnet.DhcpOptions.DNSServers != nil {
-		dnses := []string{}
-		for _, dns := range *vnet.DhcpOptions.DNSServers {
-			dnses = append(dnses, dns)
-		}
-		d.Set("dns_servers", dnses)
-	}
-
-	flattenAndSetTags(d, resp.Tags)
-
-	return nil
+	return subnets
 }
 
 func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -206,13 +214,14 @@ func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) e
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualNetworks"]
 
-	nsgNames, err := expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d)
+	nsgIds, err := expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupIDs(d)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error parsing Network Security Group ID's: %+v", err)
 	}
 
-	azureRMLockMultipleByName(&nsgNames, virtualNetworkResourceName)
-	defer azureRMUnlockMultipleByName(&nsgNames, virtualNetworkResourceName)
+	idsToLock := append(nsgIds, d.Id())
+	azureRMLockMultipleByID(idsToLock)
+	defer azureRMUnlockMultipleByID(idsToLock)
 
 	_, error := vnetClient.Delete(resGroup, name, make(chan struct{}))
 	err = <-error
@@ -234,8 +243,22 @@ func getVirtualNetworkProperties(d *schema.ResourceData, meta interface{}) (*net
 	}
 
 	// then; the subnets:
+	resGroup := d.Get("resource_group_name").(string)
+	vnetName := d.Get("name").(string)
 	subnets := []network.Subnet{}
-	if subs := d.Get("subnet").(*schema.Set); subs.Len() > 0 {
+	if !inlineChildrenAreAuthoritative(d, "subnet") {
+		// the subnets aren't managed inline, so retain those which exist (e.g. from `azurerm_subnet`)
+		existing, err := meta.(*ArmClient).vnetClient.Get(resGroup, vnetName, "")
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving existing Virtual Network %q (Resource Group %q): %+v", vnetName, resGroup, err)
+		}
+
+		if props := existing.VirtualNetworkPropertiesFormat; props != nil && props.Subnets != nil {
+			subnets = *props.Subnets
+		}
+	} else if subs := d.Get("subnet").(*schema.Set); subs.Len() > 0 {
+		logInlineChildrenToBeRemoved(d, "subnet", "azurerm_subnet")
+
 		for _, subnet := range subs.List() {
 			subnet := subnet.(map[string]interface{})
 
@@ -243,8 +266,6 @@ func getVirtualNetworkProperties(d *schema.ResourceData, meta interface{}) (*net
 			log.Printf("[INFO] setting subnets inside vNet, processing %q", name)
 			//since subnets can also be created outside of vNet definition (as root objects)
 			// do a GET on subnet properties from the server before setting them
-			resGroup := d.Get("resource_group_name").(string)
-			vnetName := d.Get("name").(string)
 			subnetObj, err := getExistingSubnet(resGroup, vnetName, name, meta)
 			if err != nil {
 				return nil, err
@@ -335,8 +356,8 @@ func getExistingSubnet(resGroup string, vnetName string, subnetName string, meta
 	return &existingSubnet, nil
 }
 
-func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d *schema.ResourceData) ([]string, error) {
-	nsgNames := make([]string, 0)
+func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupIDs(d *schema.ResourceData) ([]string, error) {
+	nsgIds := make([]string, 0)
 
 	if v, ok := d.GetOk("subnet"); ok {
 		subnets := v.(*schema.Set).List()
@@ -348,15 +369,14 @@ func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d *schema.Resou
 
 			networkSecurityGroupId := subnet["security_group"].(string)
 			if networkSecurityGroupId != "" {
-				nsgName, err := parseNetworkSecurityGroupName(networkSecurityGroupId)
-				if err != nil {
+				if _, err := parseAzureResourceID(networkSecurityGroupId); err != nil {
 					return nil, err
 				}
 
-				nsgNames = append(nsgNames, nsgName)
+				nsgIds = append(nsgIds, networkSecurityGroupId)
 			}
 		}
 	}
 
-	return nsgNames, nil
+	return nsgIds, nil
 }
@@ -88,6 +88,34 @@ func TestAccAzureRMVirtualNetwork_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetwork_standaloneSubnets(t *testing.T) {
+	rInt := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetwork_standaloneSubnets(rInt, location, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists("azurerm_virtual_network.test"),
+					testCheckAzureRMSubnetExists("azurerm_subnet.test"),
+				),
+			},
+			{
+				// updating the Virtual Network shouldn't remove the standalone subnet
+				Config: testAccAzureRMVirtualNetwork_standaloneSubnets(rInt, location, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists("azurerm_virtual_network.test"),
+					testCheckAzureRMSubnetExists("azurerm_subnet.test"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network.test", "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMVirtualNetworkExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -241,3 +269,30 @@ resource "azurerm_virtual_network" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMVirtualNetwork_standaloneSubnets(rInt int, location string, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "%s"
+  }
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+`, rInt, location, rInt, environment, rInt)
+}
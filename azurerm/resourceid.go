@@ -135,20 +135,8 @@ func composeAzureResourceID(idObj *ResourceID) (id string, err error) {
 	return
 }
 
-func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
-	id, err := parseAzureResourceID(networkSecurityGroupId)
-	if err != nil {
-		return "", fmt.Errorf("[ERROR] Unable to Parse Network Security Group ID '%s': %+v", networkSecurityGroupId, err)
-	}
-
-	return id.Path["networkSecurityGroups"], nil
-}
-
-func parseRouteTableName(routeTableId string) (string, error) {
-	id, err := parseAzureResourceID(routeTableId)
-	if err != nil {
-		return "", fmt.Errorf("[ERROR] Unable to parse Route Table ID '%s': %+v", routeTableId, err)
-	}
-
-	return id.Path["routeTables"], nil
+// networkResourceID returns the ID of a top-level Microsoft.Network resource, for
+// use where only the name of the resource is known (for example, when locking)
+func networkResourceID(subscriptionId, resourceGroup, resourceType, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/%s/%s", subscriptionId, resourceGroup, resourceType, name)
 }
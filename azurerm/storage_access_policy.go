@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// storageAccessPolicy is the common representation of a Stored Access Policy (Signed Identifier) - the Storage SDK
+// has a separate type for each service, which differ only in the permissions available.
+type storageAccessPolicy struct {
+	ID          string
+	Start       time.Time
+	Expiry      time.Time
+	Permissions string
+}
+
+// storageAccessPolicySchema returns the schema for the `access_policy` block, where `permissions` is a combination of
+// the characters in `availablePermissions`, e.g. `rwd` for a Container.
+func storageAccessPolicySchema(availablePermissions string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		// a maximum of 5 Stored Access Policies can be set on a Container, Queue, Share or Table
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+				"start": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validateRFC3339Date,
+					DiffSuppressFunc: storageAccessPolicyTimeDiffSuppress,
+				},
+				"expiry": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validateRFC3339Date,
+					DiffSuppressFunc: storageAccessPolicyTimeDiffSuppress,
+				},
+				"permissions": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validateStorageAccessPolicyPermissions(availablePermissions),
+					DiffSuppressFunc: storageAccessPolicyPermissionsDiffSuppress,
+				},
+			},
+		},
+	}
+}
+
+func validateStorageAccessPolicyPermissions(availablePermissions string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "" {
+			errors = append(errors, fmt.Errorf("%q must contain at least one of the permissions %q", k, availablePermissions))
+			return
+		}
+
+		for i, c := range value {
+			if !strings.ContainsRune(availablePermissions, c) {
+				errors = append(errors, fmt.Errorf("%q contains the unsupported permission %q, the supported permissions are %q", k, string(c), availablePermissions))
+			}
+			if strings.ContainsRune(value[:i], c) {
+				errors = append(errors, fmt.Errorf("%q contains the permission %q more than once", k, string(c)))
+			}
+		}
+
+		return
+	}
+}
+
+// the API returns the permissions in a fixed order, so the order specified in the configuration shouldn't matter
+func storageAccessPolicyPermissionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if len(old) != len(new) {
+		return false
+	}
+
+	for _, c := range new {
+		if !strings.ContainsRune(old, c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// the API returns times in UTC, so equivalent times in other time zones shouldn't cause a diff
+func storageAccessPolicyTimeDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+func expandStorageAccessPolicies(input []interface{}) ([]storageAccessPolicy, error) {
+	policies := make([]storageAccessPolicy, 0, len(input))
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		id := raw["id"].(string)
+
+		start, err := time.Parse(time.RFC3339, raw["start"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `start` for Access Policy %q: %+v", id, err)
+		}
+
+		expiry, err := time.Parse(time.RFC3339, raw["expiry"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `expiry` for Access Policy %q: %+v", id, err)
+		}
+
+		policies = append(policies, storageAccessPolicy{
+			ID:          id,
+			Start:       start,
+			Expiry:      expiry,
+			Permissions: raw["permissions"].(string),
+		})
+	}
+
+	return policies, nil
+}
+
+func flattenStorageAccessPolicies(input []storageAccessPolicy) []interface{} {
+	output := make([]interface{}, 0, len(input))
+
+	for _, policy := range input {
+		output = append(output, map[string]interface{}{
+			"id":          policy.ID,
+			"start":       policy.Start.UTC().Format(time.RFC3339),
+			"expiry":      policy.Expiry.UTC().Format(time.RFC3339),
+			"permissions": policy.Permissions,
+		})
+	}
+
+	return output
+}
+
+// storageAccessPolicyPermissions builds the permissions string from the flags on each SDK type, in the order given
+func storageAccessPolicyPermissions(permissions string, flags ...bool) string {
+	output := ""
+	for i, flag := range flags {
+		if flag {
+			output += string(permissions[i])
+		}
+	}
+	return output
+}
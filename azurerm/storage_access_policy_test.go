@@ -0,0 +1,129 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValidateStorageAccessPolicyPermissions(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "r", ErrCount: 0},
+		{Value: "rwd", ErrCount: 0},
+		{Value: "dwr", ErrCount: 0},
+		{Value: "", ErrCount: 1},
+		{Value: "rl", ErrCount: 1},
+		{Value: "rr", ErrCount: 1},
+		{Value: "R", ErrCount: 1},
+	}
+
+	validate := validateStorageAccessPolicyPermissions("rwd")
+	for _, tc := range cases {
+		_, errors := validate(tc.Value, "permissions")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors validating %q but got %d: %+v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestStorageAccessPolicyPermissionsDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{"rwd", "rwd", true},
+		{"rwd", "dwr", true},
+		{"rw", "rwd", false},
+		{"rwd", "rw", false},
+		{"rw", "rd", false},
+	}
+
+	for _, tc := range cases {
+		if actual := storageAccessPolicyPermissionsDiffSuppress("permissions", tc.Old, tc.New, nil); actual != tc.Suppress {
+			t.Fatalf("Expected %t for %q -> %q but got %t", tc.Suppress, tc.Old, tc.New, actual)
+		}
+	}
+}
+
+func TestStorageAccessPolicyTimeDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{"2018-01-01T00:00:00Z", "2018-01-01T00:00:00Z", true},
+		{"2018-01-01T00:00:00Z", "2018-01-01T01:00:00+01:00", true},
+		{"2018-01-01T00:00:00Z", "2018-01-01T01:00:00Z", false},
+		{"", "2018-01-01T00:00:00Z", false},
+	}
+
+	for _, tc := range cases {
+		if actual := storageAccessPolicyTimeDiffSuppress("start", tc.Old, tc.New, nil); actual != tc.Suppress {
+			t.Fatalf("Expected %t for %q -> %q but got %t", tc.Suppress, tc.Old, tc.New, actual)
+		}
+	}
+}
+
+func TestExpandStorageAccessPolicies(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"id":          "policy1",
+			"start":       "2018-01-01T01:00:00+01:00",
+			"expiry":      "2019-01-01T00:00:00Z",
+			"permissions": "rw",
+		},
+	}
+
+	expected := []storageAccessPolicy{
+		{
+			ID:          "policy1",
+			Start:       time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expiry:      time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Permissions: "rw",
+		},
+	}
+
+	actual, err := expandStorageAccessPolicies(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d policies but got %d", len(expected), len(actual))
+	}
+	for i := range expected {
+		if actual[i].ID != expected[i].ID || actual[i].Permissions != expected[i].Permissions ||
+			!actual[i].Start.Equal(expected[i].Start) || !actual[i].Expiry.Equal(expected[i].Expiry) {
+			t.Fatalf("Expected %+v but got %+v", expected[i], actual[i])
+		}
+	}
+}
+
+func TestFlattenStorageAccessPolicies(t *testing.T) {
+	input := []storageAccessPolicy{
+		{
+			ID:          "policy1",
+			Start:       time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expiry:      time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Permissions: storageAccessPolicyPermissions("raud", true, false, true, true),
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":          "policy1",
+			"start":       "2018-01-01T00:00:00Z",
+			"expiry":      "2019-01-01T00:00:00Z",
+			"permissions": "rud",
+		},
+	}
+
+	actual := flattenStorageAccessPolicies(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
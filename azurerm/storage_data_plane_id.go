@@ -0,0 +1,71 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// StorageDataPlaneID represents a parsed URL for an object within a Storage
+// Account's Blob, File, Queue or Table service, such as
+// `https://account1.file.core.windows.net/share1/dir1/file1`.
+type StorageDataPlaneID struct {
+	AccountName string
+	Service     string
+	Path        string
+}
+
+// parseStorageDataPlaneID converts a data plane URL into a StorageDataPlaneID,
+// the Path has the leading slash removed and is unescaped.
+func parseStorageDataPlaneID(id string) (*StorageDataPlaneID, error) {
+	u, err := url.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Storage ID %q: %s", id, err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("Expected the Storage ID %q to be a URL", id)
+	}
+
+	hostSegments := strings.SplitN(u.Host, ".", 3)
+	if len(hostSegments) != 3 {
+		return nil, fmt.Errorf("Expected the Storage ID %q to have a host in the format `{account}.{service}.{suffix}`", id)
+	}
+
+	path := strings.TrimPrefix(u.Path, "/")
+	if path == "" {
+		return nil, fmt.Errorf("Expected the Storage ID %q to contain a path", id)
+	}
+
+	return &StorageDataPlaneID{
+		AccountName: hostSegments[0],
+		Service:     hostSegments[1],
+		Path:        path,
+	}, nil
+}
+
+// findResourceGroupForStorageAccount looks up the Resource Group containing
+// the Storage Account, since this isn't available from a data plane URL.
+func (armClient *ArmClient) findResourceGroupForStorageAccount(storageAccountName string) (string, error) {
+	accounts, err := armClient.storageServiceClient.List()
+	if err != nil {
+		return "", fmt.Errorf("Error listing Storage Accounts: %+v", err)
+	}
+
+	if accounts.Value != nil {
+		for _, account := range *accounts.Value {
+			if account.Name == nil || account.ID == nil || !strings.EqualFold(*account.Name, storageAccountName) {
+				continue
+			}
+
+			id, err := parseAzureResourceID(*account.ID)
+			if err != nil {
+				return "", err
+			}
+
+			return id.ResourceGroup, nil
+		}
+	}
+
+	return "", fmt.Errorf("Storage Account %q was not found in this Subscription", storageAccountName)
+}
@@ -0,0 +1,81 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStorageDataPlaneID(t *testing.T) {
+	testCases := []struct {
+		id          string
+		expected    *StorageDataPlaneID
+		expectError bool
+	}{
+		{
+			"random",
+			nil,
+			true,
+		},
+		{
+			"/share1/dir1",
+			nil,
+			true,
+		},
+		{
+			// Missing a path
+			"https://account1.file.core.windows.net/",
+			nil,
+			true,
+		},
+		{
+			// Missing the service
+			"https://account1/share1",
+			nil,
+			true,
+		},
+		{
+			"https://account1.file.core.windows.net/share1/dir1",
+			&StorageDataPlaneID{
+				AccountName: "account1",
+				Service:     "file",
+				Path:        "share1/dir1",
+			},
+			false,
+		},
+		{
+			"https://account1.file.core.chinacloudapi.cn/share1/dir%201/file1.txt",
+			&StorageDataPlaneID{
+				AccountName: "account1",
+				Service:     "file",
+				Path:        "share1/dir 1/file1.txt",
+			},
+			false,
+		},
+		{
+			"https://account1.table.core.windows.net/table1(PartitionKey='pk',RowKey='rk')",
+			&StorageDataPlaneID{
+				AccountName: "account1",
+				Service:     "table",
+				Path:        "table1(PartitionKey='pk',RowKey='rk')",
+			},
+			false,
+		},
+	}
+
+	for _, test := range testCases {
+		parsed, err := parseStorageDataPlaneID(test.id)
+		if test.expectError && err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if test.expectError {
+			t.Fatalf("Expected an error parsing %q but got none", test.id)
+		}
+
+		if !reflect.DeepEqual(test.expected, parsed) {
+			t.Fatalf("Unexpected data plane ID:\nExpected: %+v\nGot:      %+v\n", test.expected, parsed)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package azurerm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// the version of the Storage Service used to sign Shared Access Signatures
+const storageSASSignedVersion = "2016-05-31"
+
+// the format used for the start and expiry times within a Shared Access Signature
+const storageSASTimeFormat = "2006-01-02T15:04:05Z"
+
+type storageAccountSASParameters struct {
+	AccountName   string
+	AccountKey    string
+	Services      string
+	ResourceTypes string
+	Permissions   string
+	Start         string
+	Expiry        string
+	IPRange       string
+	HTTPSOnly     bool
+}
+
+type storageBlobSASParameters struct {
+	AccountName   string
+	AccountKey    string
+	ContainerName string
+	BlobName      string
+	Permissions   string
+	Start         string
+	Expiry        string
+	IPRange       string
+	HTTPSOnly     bool
+}
+
+// computeStorageAccountSASToken returns the query string for an Account Shared Access Signature - which is signed
+// locally using the Account Key, rather than via the Storage API.
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/constructing-an-account-sas
+func computeStorageAccountSASToken(params storageAccountSASParameters) (string, error) {
+	start, expiry, err := formatStorageSASTimes(params.Start, params.Expiry)
+	if err != nil {
+		return "", err
+	}
+
+	protocols := storageSASProtocols(params.HTTPSOnly)
+
+	stringToSign := strings.Join([]string{
+		params.AccountName,
+		params.Permissions,
+		params.Services,
+		params.ResourceTypes,
+		start,
+		expiry,
+		params.IPRange,
+		protocols,
+		storageSASSignedVersion,
+		"",
+	}, "\n")
+
+	signature, err := computeStorageSASSignature(params.AccountKey, stringToSign)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{
+		"sv":  {storageSASSignedVersion},
+		"ss":  {params.Services},
+		"srt": {params.ResourceTypes},
+		"sp":  {params.Permissions},
+		"se":  {expiry},
+		"spr": {protocols},
+		"sig": {signature},
+	}
+
+	if start != "" {
+		values.Add("st", start)
+	}
+
+	if params.IPRange != "" {
+		values.Add("sip", params.IPRange)
+	}
+
+	return values.Encode(), nil
+}
+
+// computeStorageBlobSASToken returns the query string for a Service Shared Access Signature scoped to either a
+// Blob or (when no Blob Name is specified) a Container - which is signed locally using the Account Key.
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/constructing-a-service-sas
+func computeStorageBlobSASToken(params storageBlobSASParameters) (string, error) {
+	start, expiry, err := formatStorageSASTimes(params.Start, params.Expiry)
+	if err != nil {
+		return "", err
+	}
+
+	protocols := storageSASProtocols(params.HTTPSOnly)
+
+	signedResource := "c"
+	canonicalizedResource := fmt.Sprintf("/blob/%s/%s", params.AccountName, params.ContainerName)
+	if params.BlobName != "" {
+		signedResource = "b"
+		canonicalizedResource = fmt.Sprintf("%s/%s", canonicalizedResource, params.BlobName)
+	}
+
+	// the signed identifier and response header overrides aren't supported, so are left empty
+	stringToSign := strings.Join([]string{
+		params.Permissions,
+		start,
+		expiry,
+		canonicalizedResource,
+		"",
+		params.IPRange,
+		protocols,
+		storageSASSignedVersion,
+		"",
+		"",
+		"",
+		"",
+		"",
+	}, "\n")
+
+	signature, err := computeStorageSASSignature(params.AccountKey, stringToSign)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{
+		"sv":  {storageSASSignedVersion},
+		"sr":  {signedResource},
+		"sp":  {params.Permissions},
+		"se":  {expiry},
+		"spr": {protocols},
+		"sig": {signature},
+	}
+
+	if start != "" {
+		values.Add("st", start)
+	}
+
+	if params.IPRange != "" {
+		values.Add("sip", params.IPRange)
+	}
+
+	return values.Encode(), nil
+}
+
+func computeStorageSASSignature(accountKey string, stringToSign string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding the Storage Account Key: %+v", err)
+	}
+
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func formatStorageSASTimes(start string, expiry string) (string, string, error) {
+	formattedStart := ""
+	if start != "" {
+		t, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return "", "", fmt.Errorf("Error parsing `start` %q: %+v", start, err)
+		}
+		formattedStart = t.UTC().Format(storageSASTimeFormat)
+	}
+
+	t, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return "", "", fmt.Errorf("Error parsing `expiry` %q: %+v", expiry, err)
+	}
+	formattedExpiry := t.UTC().Format(storageSASTimeFormat)
+
+	return formattedStart, formattedExpiry, nil
+}
+
+func storageSASProtocols(httpsOnly bool) string {
+	if httpsOnly {
+		return "https"
+	}
+
+	return "https,http"
+}
+
+// buildStorageSASFlags returns the flags enabled within the specified block, in the order required by the Storage API
+func buildStorageSASFlags(input []interface{}, flags []storageSASFlag) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+
+	values := input[0].(map[string]interface{})
+	result := ""
+	for _, flag := range flags {
+		if enabled, ok := values[flag.Key].(bool); ok && enabled {
+			result += flag.Value
+		}
+	}
+
+	return result
+}
+
+type storageSASFlag struct {
+	Key   string
+	Value string
+}
+
+func validateStorageSASIPRange(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	ips := strings.Split(value, "-")
+	if len(ips) > 2 {
+		errors = append(errors, fmt.Errorf("%q must be either a single IP Address or a range in the format `{start}-{end}`: %q", k, value))
+		return
+	}
+
+	for _, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid IP Address: %q", k, ip))
+		}
+	}
+
+	return
+}
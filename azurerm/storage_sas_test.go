@@ -0,0 +1,222 @@
+package azurerm
+
+import (
+	"net/url"
+	"testing"
+)
+
+// a Base64 encoded key used to sign the Shared Access Signatures, which doesn't belong to any Storage Account
+const testStorageSASAccountKey = "dGVycmFmb3JtLXByb3ZpZGVyLWF6dXJlcm0tdGVzdC1rZXk="
+
+func TestComputeStorageAccountSASToken(t *testing.T) {
+	cases := []struct {
+		Params   storageAccountSASParameters
+		Expected map[string]string
+	}{
+		{
+			Params: storageAccountSASParameters{
+				AccountName:   "acctestsa",
+				AccountKey:    testStorageSASAccountKey,
+				Services:      "bf",
+				ResourceTypes: "sco",
+				Permissions:   "rwl",
+				Start:         "2017-01-01T00:00:00Z",
+				Expiry:        "2018-01-01T00:00:00Z",
+				IPRange:       "168.1.5.60-168.1.5.70",
+				HTTPSOnly:     true,
+			},
+			Expected: map[string]string{
+				"sv":  "2016-05-31",
+				"ss":  "bf",
+				"srt": "sco",
+				"sp":  "rwl",
+				"st":  "2017-01-01T00:00:00Z",
+				"se":  "2018-01-01T00:00:00Z",
+				"sip": "168.1.5.60-168.1.5.70",
+				"spr": "https",
+				"sig": "tlgFCCWAij6QpfAHnzHI/qWweNISiyvb2hNdo3y1fyY=",
+			},
+		},
+		{
+			// times in other timezones are converted to UTC, and the start & IP range are optional
+			Params: storageAccountSASParameters{
+				AccountName:   "acctestsa",
+				AccountKey:    testStorageSASAccountKey,
+				Services:      "b",
+				ResourceTypes: "o",
+				Permissions:   "r",
+				Expiry:        "2018-01-01T02:02:03+01:00",
+				HTTPSOnly:     false,
+			},
+			Expected: map[string]string{
+				"sv":  "2016-05-31",
+				"ss":  "b",
+				"srt": "o",
+				"sp":  "r",
+				"se":  "2018-01-01T01:02:03Z",
+				"spr": "https,http",
+				"sig": "aKD++yzEvbq2ekm2utY2K6CY7FR7BmEMjY0CnOW9iLw=",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		token, err := computeStorageAccountSASToken(tc.Params)
+		if err != nil {
+			t.Fatalf("Error computing the Account SAS Token: %+v", err)
+		}
+
+		testCheckStorageSASToken(t, token, tc.Expected)
+	}
+}
+
+func TestComputeStorageBlobSASToken(t *testing.T) {
+	cases := []struct {
+		Params   storageBlobSASParameters
+		Expected map[string]string
+	}{
+		{
+			Params: storageBlobSASParameters{
+				AccountName:   "acctestsa",
+				AccountKey:    testStorageSASAccountKey,
+				ContainerName: "container1",
+				BlobName:      "blob.vhd",
+				Permissions:   "rw",
+				Start:         "2017-01-01T00:00:00Z",
+				Expiry:        "2018-01-01T00:00:00Z",
+				HTTPSOnly:     true,
+			},
+			Expected: map[string]string{
+				"sv":  "2016-05-31",
+				"sr":  "b",
+				"sp":  "rw",
+				"st":  "2017-01-01T00:00:00Z",
+				"se":  "2018-01-01T00:00:00Z",
+				"spr": "https",
+				"sig": "+U1+vZTkDdcrTNf2yEqMF9l/67+7v2936E5ruB9aosc=",
+			},
+		},
+		{
+			Params: storageBlobSASParameters{
+				AccountName:   "acctestsa",
+				AccountKey:    testStorageSASAccountKey,
+				ContainerName: "container1",
+				Permissions:   "rl",
+				Expiry:        "2018-01-01T00:00:00Z",
+				IPRange:       "10.0.0.1",
+				HTTPSOnly:     false,
+			},
+			Expected: map[string]string{
+				"sv":  "2016-05-31",
+				"sr":  "c",
+				"sp":  "rl",
+				"se":  "2018-01-01T00:00:00Z",
+				"sip": "10.0.0.1",
+				"spr": "https,http",
+				"sig": "8PShb3N1MulP1TbYoqNXmGlwJ8D0i4LwHavoMb3ZjWE=",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		token, err := computeStorageBlobSASToken(tc.Params)
+		if err != nil {
+			t.Fatalf("Error computing the Blob SAS Token: %+v", err)
+		}
+
+		testCheckStorageSASToken(t, token, tc.Expected)
+	}
+}
+
+func TestComputeStorageSASToken_invalid(t *testing.T) {
+	_, err := computeStorageAccountSASToken(storageAccountSASParameters{
+		AccountName: "acctestsa",
+		AccountKey:  "not-base64!",
+		Expiry:      "2018-01-01T00:00:00Z",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error for an invalid Account Key but didn't get one")
+	}
+
+	_, err = computeStorageBlobSASToken(storageBlobSASParameters{
+		AccountName: "acctestsa",
+		AccountKey:  testStorageSASAccountKey,
+		Expiry:      "2018-01-01",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error for an invalid Expiry but didn't get one")
+	}
+}
+
+func TestBuildStorageSASFlags(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"process": true,
+			"read":    true,
+			"list":    true,
+			"write":   false,
+		},
+	}
+
+	// the flags should be ordered as the API requires, rather than as specified
+	if flags := buildStorageSASFlags(input, storageAccountSASPermissionFlags); flags != "rlp" {
+		t.Fatalf("Expected the flags to be %q but got %q", "rlp", flags)
+	}
+
+	if flags := buildStorageSASFlags([]interface{}{}, storageAccountSASPermissionFlags); flags != "" {
+		t.Fatalf("Expected no flags but got %q", flags)
+	}
+}
+
+func TestValidateStorageSASIPRange(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "10.0.0.1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "168.1.5.60-168.1.5.70",
+			ErrCount: 0,
+		},
+		{
+			Value:    "10.0.0",
+			ErrCount: 1,
+		},
+		{
+			Value:    "10.0.0.1-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "10.0.0.1-10.0.0.2-10.0.0.3",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateStorageSASIPRange(tc.Value, "ip_range")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected validateStorageSASIPRange to return %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testCheckStorageSASToken(t *testing.T, token string, expected map[string]string) {
+	values, err := url.ParseQuery(token)
+	if err != nil {
+		t.Fatalf("Error parsing the SAS Token %q: %+v", token, err)
+	}
+
+	if len(values) != len(expected) {
+		t.Fatalf("Expected %d parameters in the SAS Token but got %d: %q", len(expected), len(values), token)
+	}
+
+	for key, value := range expected {
+		if actual := values.Get(key); actual != value {
+			t.Fatalf("Expected %q to be %q but got %q", key, value, actual)
+		}
+	}
+}
@@ -0,0 +1,381 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the version of Storage Analytics used for both Logging and Metrics
+const storageServicePropertiesAnalyticsVersion = "1.0"
+
+// the Storage Services which support configuring the Service Properties, keyed by the name of the block
+var storageServicePropertiesBlocks = map[string]string{
+	"blob_properties":  "blob",
+	"queue_properties": "queue",
+	"table_properties": "table",
+}
+
+type storageServicePropertiesClient interface {
+	GetServiceProperties() (*storage.ServiceProperties, error)
+	SetServiceProperties(props storage.ServiceProperties) error
+}
+
+func storageServicePropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cors_rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 5,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_origins": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 64,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"allowed_methods": {
+								Type:     schema.TypeList,
+								Required: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{
+										"DELETE",
+										"GET",
+										"HEAD",
+										"MERGE",
+										"POST",
+										"OPTIONS",
+										"PUT",
+									}, false),
+								},
+							},
+							"allowed_headers": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 64,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"exposed_headers": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 64,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"max_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 2000000000),
+							},
+						},
+					},
+				},
+
+				"logging": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+							"read": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+							"write": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+							"retention_policy_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 365),
+							},
+						},
+					},
+				},
+
+				"hour_metrics":   storageServicePropertiesMetricsSchema(),
+				"minute_metrics": storageServicePropertiesMetricsSchema(),
+			},
+		},
+	}
+}
+
+func storageServicePropertiesMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"include_apis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"retention_policy_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func getStorageServicePropertiesClient(armClient *ArmClient, resourceGroupName string, storageAccountName string, service string) (storageServicePropertiesClient, bool, error) {
+	switch service {
+	case "blob":
+		return armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
+	case "queue":
+		return armClient.getQueueServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	case "table":
+		return armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	}
+
+	return nil, false, fmt.Errorf("Unsupported Storage Service %q", service)
+}
+
+// storageAccountSupportsService returns whether the Queue and Table services are available for this Storage Account,
+// since these aren't available for either Blob Storage or Premium accounts.
+func storageAccountSupportsService(accountKind string, accountType string, service string) bool {
+	if service == "blob" {
+		return true
+	}
+
+	return strings.EqualFold(accountKind, "Storage") && !strings.HasPrefix(strings.ToLower(accountType), "premium")
+}
+
+func updateStorageAccountServiceProperties(d *schema.ResourceData, meta interface{}, resourceGroupName string, storageAccountName string, key string) error {
+	service := storageServicePropertiesBlocks[key]
+
+	client, accountExists, err := getStorageServicePropertiesClient(meta.(*ArmClient), resourceGroupName, storageAccountName, service)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	properties := expandStorageServiceProperties(d.Get(key).([]interface{}))
+
+	log.Printf("[DEBUG] Updating the %s Service Properties for Storage Account %q", service, storageAccountName)
+	if err := client.SetServiceProperties(properties); err != nil {
+		return fmt.Errorf("Error updating the %s Service Properties for Storage Account %q: %s", service, storageAccountName, err)
+	}
+
+	return nil
+}
+
+func readStorageAccountServiceProperties(d *schema.ResourceData, meta interface{}, resourceGroupName string, storageAccountName string, key string) error {
+	service := storageServicePropertiesBlocks[key]
+
+	client, accountExists, err := getStorageServicePropertiesClient(meta.(*ArmClient), resourceGroupName, storageAccountName, service)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return nil
+	}
+
+	properties, err := client.GetServiceProperties()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the %s Service Properties for Storage Account %q: %s", service, storageAccountName, err)
+	}
+
+	if err := d.Set(key, flattenStorageServiceProperties(properties)); err != nil {
+		return fmt.Errorf("Error flattening `%s`: %+v", key, err)
+	}
+
+	return nil
+}
+
+// expandStorageServiceProperties returns the complete set of Service Properties, such that any omitted blocks
+// (for example the `logging` block) are disabled, rather than being left unchanged.
+func expandStorageServiceProperties(input []interface{}) storage.ServiceProperties {
+	values := make(map[string]interface{})
+	if len(input) > 0 && input[0] != nil {
+		values = input[0].(map[string]interface{})
+	}
+
+	corsRules := make([]storage.CorsRule, 0)
+	if v, ok := values["cors_rule"].([]interface{}); ok {
+		for _, raw := range v {
+			rule := raw.(map[string]interface{})
+			corsRules = append(corsRules, storage.CorsRule{
+				AllowedOrigins:  expandStorageServicePropertiesList(rule["allowed_origins"]),
+				AllowedMethods:  expandStorageServicePropertiesList(rule["allowed_methods"]),
+				AllowedHeaders:  expandStorageServicePropertiesList(rule["allowed_headers"]),
+				ExposedHeaders:  expandStorageServicePropertiesList(rule["exposed_headers"]),
+				MaxAgeInSeconds: rule["max_age_in_seconds"].(int),
+			})
+		}
+	}
+
+	logging := storage.Logging{
+		Version:         storageServicePropertiesAnalyticsVersion,
+		RetentionPolicy: expandStorageServicePropertiesRetentionPolicy(0),
+	}
+	if v, ok := values["logging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config := v[0].(map[string]interface{})
+		logging.Delete = config["delete"].(bool)
+		logging.Read = config["read"].(bool)
+		logging.Write = config["write"].(bool)
+		logging.RetentionPolicy = expandStorageServicePropertiesRetentionPolicy(config["retention_policy_days"].(int))
+	}
+
+	return storage.ServiceProperties{
+		Cors: &storage.Cors{
+			CorsRule: corsRules,
+		},
+		Logging:       &logging,
+		HourMetrics:   expandStorageServicePropertiesMetrics(values["hour_metrics"]),
+		MinuteMetrics: expandStorageServicePropertiesMetrics(values["minute_metrics"]),
+	}
+}
+
+func expandStorageServicePropertiesMetrics(input interface{}) *storage.Metrics {
+	metrics := storage.Metrics{
+		Version:         storageServicePropertiesAnalyticsVersion,
+		RetentionPolicy: expandStorageServicePropertiesRetentionPolicy(0),
+	}
+
+	if v, ok := input.([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config := v[0].(map[string]interface{})
+		metrics.Enabled = config["enabled"].(bool)
+		metrics.RetentionPolicy = expandStorageServicePropertiesRetentionPolicy(config["retention_policy_days"].(int))
+
+		// `IncludeAPIs` can only be specified when metrics are enabled
+		if metrics.Enabled {
+			metrics.IncludeAPIs = utils.Bool(config["include_apis"].(bool))
+		}
+	}
+
+	return &metrics
+}
+
+func expandStorageServicePropertiesRetentionPolicy(days int) *storage.RetentionPolicy {
+	if days == 0 {
+		return &storage.RetentionPolicy{
+			Enabled: false,
+		}
+	}
+
+	return &storage.RetentionPolicy{
+		Enabled: true,
+		Days:    utils.Int(days),
+	}
+}
+
+func expandStorageServicePropertiesList(input interface{}) string {
+	values := make([]string, 0)
+	for _, v := range input.([]interface{}) {
+		values = append(values, v.(string))
+	}
+
+	return strings.Join(values, ",")
+}
+
+func flattenStorageServiceProperties(input *storage.ServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	corsRules := make([]interface{}, 0)
+	if input.Cors != nil {
+		for _, rule := range input.Cors.CorsRule {
+			corsRules = append(corsRules, map[string]interface{}{
+				"allowed_origins":    flattenStorageServicePropertiesList(rule.AllowedOrigins),
+				"allowed_methods":    flattenStorageServicePropertiesList(rule.AllowedMethods),
+				"allowed_headers":    flattenStorageServicePropertiesList(rule.AllowedHeaders),
+				"exposed_headers":    flattenStorageServicePropertiesList(rule.ExposedHeaders),
+				"max_age_in_seconds": rule.MaxAgeInSeconds,
+			})
+		}
+	}
+
+	// disabled logging & metrics are omitted, so that there's no diff when these blocks aren't specified
+	logging := make([]interface{}, 0)
+	if v := input.Logging; v != nil {
+		retentionDays := flattenStorageServicePropertiesRetentionPolicy(v.RetentionPolicy)
+		if v.Delete || v.Read || v.Write || retentionDays != 0 {
+			logging = append(logging, map[string]interface{}{
+				"delete":                v.Delete,
+				"read":                  v.Read,
+				"write":                 v.Write,
+				"retention_policy_days": retentionDays,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":      corsRules,
+			"logging":        logging,
+			"hour_metrics":   flattenStorageServicePropertiesMetrics(input.HourMetrics),
+			"minute_metrics": flattenStorageServicePropertiesMetrics(input.MinuteMetrics),
+		},
+	}
+}
+
+func flattenStorageServicePropertiesMetrics(input *storage.Metrics) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	retentionDays := flattenStorageServicePropertiesRetentionPolicy(input.RetentionPolicy)
+	if !input.Enabled && retentionDays == 0 {
+		return []interface{}{}
+	}
+
+	includeAPIs := false
+	if input.IncludeAPIs != nil {
+		includeAPIs = *input.IncludeAPIs
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":               input.Enabled,
+			"include_apis":          includeAPIs,
+			"retention_policy_days": retentionDays,
+		},
+	}
+}
+
+func flattenStorageServicePropertiesRetentionPolicy(input *storage.RetentionPolicy) int {
+	if input == nil || !input.Enabled || input.Days == nil {
+		return 0
+	}
+
+	return *input.Days
+}
+
+func flattenStorageServicePropertiesList(input string) []interface{} {
+	values := make([]interface{}, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
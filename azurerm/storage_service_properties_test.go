@@ -0,0 +1,194 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandStorageServiceProperties(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"cors_rule": []interface{}{
+				map[string]interface{}{
+					"allowed_origins":    []interface{}{"https://example.com", "https://www.example.com"},
+					"allowed_methods":    []interface{}{"GET", "PUT"},
+					"allowed_headers":    []interface{}{"x-ms-meta-*"},
+					"exposed_headers":    []interface{}{"*"},
+					"max_age_in_seconds": 3600,
+				},
+			},
+			"logging": []interface{}{
+				map[string]interface{}{
+					"delete":                true,
+					"read":                  false,
+					"write":                 true,
+					"retention_policy_days": 7,
+				},
+			},
+			"hour_metrics": []interface{}{
+				map[string]interface{}{
+					"enabled":               true,
+					"include_apis":          true,
+					"retention_policy_days": 0,
+				},
+			},
+			"minute_metrics": []interface{}{},
+		},
+	}
+
+	expected := storage.ServiceProperties{
+		Cors: &storage.Cors{
+			CorsRule: []storage.CorsRule{
+				{
+					AllowedOrigins:  "https://example.com,https://www.example.com",
+					AllowedMethods:  "GET,PUT",
+					AllowedHeaders:  "x-ms-meta-*",
+					ExposedHeaders:  "*",
+					MaxAgeInSeconds: 3600,
+				},
+			},
+		},
+		Logging: &storage.Logging{
+			Version: "1.0",
+			Delete:  true,
+			Read:    false,
+			Write:   true,
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: true,
+				Days:    utils.Int(7),
+			},
+		},
+		HourMetrics: &storage.Metrics{
+			Version:     "1.0",
+			Enabled:     true,
+			IncludeAPIs: utils.Bool(true),
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: false,
+			},
+		},
+		MinuteMetrics: &storage.Metrics{
+			Version: "1.0",
+			Enabled: false,
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: false,
+			},
+		},
+	}
+
+	actual := expandStorageServiceProperties(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestExpandStorageServicePropertiesEmpty(t *testing.T) {
+	// omitted blocks should be disabled, rather than left unchanged
+	actual := expandStorageServiceProperties([]interface{}{})
+
+	if actual.Cors == nil || len(actual.Cors.CorsRule) != 0 {
+		t.Fatalf("Expected an empty list of CORS rules but got %+v", actual.Cors)
+	}
+
+	if actual.Logging == nil || actual.Logging.Delete || actual.Logging.Read || actual.Logging.Write {
+		t.Fatalf("Expected Logging to be disabled but got %+v", actual.Logging)
+	}
+
+	for _, metrics := range []*storage.Metrics{actual.HourMetrics, actual.MinuteMetrics} {
+		if metrics == nil || metrics.Enabled || metrics.IncludeAPIs != nil {
+			t.Fatalf("Expected Metrics to be disabled but got %+v", metrics)
+		}
+	}
+}
+
+func TestFlattenStorageServiceProperties(t *testing.T) {
+	input := &storage.ServiceProperties{
+		Cors: &storage.Cors{
+			CorsRule: []storage.CorsRule{
+				{
+					AllowedOrigins:  "https://example.com, https://www.example.com",
+					AllowedMethods:  "GET",
+					AllowedHeaders:  "",
+					ExposedHeaders:  "*",
+					MaxAgeInSeconds: 60,
+				},
+			},
+		},
+		Logging: &storage.Logging{
+			Version: "1.0",
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: false,
+			},
+		},
+		HourMetrics: &storage.Metrics{
+			Version:     "1.0",
+			Enabled:     true,
+			IncludeAPIs: utils.Bool(true),
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: true,
+				Days:    utils.Int(7),
+			},
+		},
+		MinuteMetrics: &storage.Metrics{
+			Version: "1.0",
+			Enabled: false,
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: false,
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"cors_rule": []interface{}{
+				map[string]interface{}{
+					"allowed_origins":    []interface{}{"https://example.com", "https://www.example.com"},
+					"allowed_methods":    []interface{}{"GET"},
+					"allowed_headers":    []interface{}{},
+					"exposed_headers":    []interface{}{"*"},
+					"max_age_in_seconds": 60,
+				},
+			},
+			"logging": []interface{}{},
+			"hour_metrics": []interface{}{
+				map[string]interface{}{
+					"enabled":               true,
+					"include_apis":          true,
+					"retention_policy_days": 7,
+				},
+			},
+			"minute_metrics": []interface{}{},
+		},
+	}
+
+	actual := flattenStorageServiceProperties(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestStorageAccountSupportsService(t *testing.T) {
+	cases := []struct {
+		Kind     string
+		Type     string
+		Service  string
+		Expected bool
+	}{
+		{"Storage", "Standard_LRS", "blob", true},
+		{"Storage", "Standard_LRS", "queue", true},
+		{"Storage", "Standard_GRS", "table", true},
+		{"Storage", "Premium_LRS", "blob", true},
+		{"Storage", "Premium_LRS", "queue", false},
+		{"BlobStorage", "Standard_LRS", "blob", true},
+		{"BlobStorage", "Standard_LRS", "table", false},
+	}
+
+	for _, tc := range cases {
+		actual := storageAccountSupportsService(tc.Kind, tc.Type, tc.Service)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %t for the %q service in a %q %q account but got %t", tc.Expected, tc.Service, tc.Kind, tc.Type, actual)
+		}
+	}
+}
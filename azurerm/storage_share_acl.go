@@ -0,0 +1,204 @@
+package azurerm
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+)
+
+// The vendored Storage SDK doesn't support the Get/Set Share ACL operations, so storageShareACLClient makes these
+// requests directly against the File Service, using Shared Key authorization in the same way as the SDK.
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/get-share-acl
+type storageShareACLClient struct {
+	accountName string
+	accountKey  string
+	fileClient  *storage.FileServiceClient
+	httpClient  *http.Client
+}
+
+func (armClient *ArmClient) getShareACLClientForStorageAccount(resourceGroupName, storageAccountName string) (*storageShareACLClient, bool, error) {
+	key, accountExists, err := armClient.getKeyForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, accountExists, err
+	}
+	if !accountExists {
+		return nil, false, nil
+	}
+
+	fileClient, _, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, true, err
+	}
+
+	client := storageShareACLClient{
+		accountName: storageAccountName,
+		accountKey:  key,
+		fileClient:  fileClient,
+		httpClient:  http.DefaultClient,
+	}
+	return &client, true, nil
+}
+
+func (c storageShareACLClient) GetShareACL(shareName string) ([]storage.SignedIdentifier, error) {
+	resp, err := c.send("GET", shareName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var identifiers storage.SignedIdentifiers
+	if err := xml.Unmarshal(resp, &identifiers); err != nil {
+		return nil, fmt.Errorf("Error parsing the ACL for Share %q: %+v", shareName, err)
+	}
+
+	return identifiers.SignedIdentifiers, nil
+}
+
+func (c storageShareACLClient) SetShareACL(shareName string, identifiers []storage.SignedIdentifier) error {
+	body, err := xml.Marshal(storage.SignedIdentifiers{
+		SignedIdentifiers: identifiers,
+	})
+	if err != nil {
+		return fmt.Errorf("Error serializing the ACL for Share %q: %+v", shareName, err)
+	}
+
+	_, err = c.send("PUT", shareName, body)
+	return err
+}
+
+func (c storageShareACLClient) send(verb string, shareName string, body []byte) ([]byte, error) {
+	uri := fmt.Sprintf("%s?comp=acl&restype=share", c.fileClient.GetShareReference(shareName).URL())
+
+	req, err := http.NewRequest(verb, uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"x-ms-date":    time.Now().UTC().Format(http.TimeFormat),
+		"x-ms-version": storage.DefaultAPIVersion,
+	}
+	if len(body) > 0 {
+		headers["Content-Length"] = strconv.Itoa(len(body))
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	req.ContentLength = int64(len(body))
+
+	authorization, err := c.authorizationHeader(verb, shareName, headers)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", authorization)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error sending the %s ACL request for Share %q: %+v", verb, shareName, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the ACL response for Share %q: %+v", shareName, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status code %d from the %s ACL request for Share %q: %s", resp.StatusCode, verb, shareName, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+// authorizationHeader builds the Shared Key authorization header for a Share ACL request
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (c storageShareACLClient) authorizationHeader(verb string, shareName string, headers map[string]string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(c.accountKey)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding the key for Storage Account %q: %+v", c.accountName, err)
+	}
+
+	stringToSign := buildStorageShareACLStringToSign(verb, c.accountName, shareName, headers)
+
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	return fmt.Sprintf("SharedKey %s:%s", c.accountName, signature), nil
+}
+
+func buildStorageShareACLStringToSign(verb string, accountName string, shareName string, headers map[string]string) string {
+	canonicalizedHeaders := make([]string, 0)
+	for k, v := range headers {
+		name := strings.ToLower(k)
+		if strings.HasPrefix(name, "x-ms-") {
+			canonicalizedHeaders = append(canonicalizedHeaders, fmt.Sprintf("%s:%s", name, v))
+		}
+	}
+	sort.Strings(canonicalizedHeaders)
+
+	canonicalizedResource := fmt.Sprintf("/%s/%s\ncomp:acl\nrestype:share", accountName, shareName)
+
+	// the Date header is empty since `x-ms-date` is specified
+	return strings.Join([]string{
+		verb,
+		"", // Content-Encoding
+		"", // Content-Language
+		headers["Content-Length"],
+		"", // Content-MD5
+		"", // Content-Type
+		"", // Date
+		"", // If-Modified-Since
+		"", // If-Match
+		"", // If-None-Match
+		"", // If-Unmodified-Since
+		"", // Range
+		strings.Join(canonicalizedHeaders, "\n"),
+		canonicalizedResource,
+	}, "\n")
+}
+
+func expandStorageShareAccessPolicies(input []interface{}) ([]storage.SignedIdentifier, error) {
+	policies, err := expandStorageAccessPolicies(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]storage.SignedIdentifier, 0, len(policies))
+	for _, policy := range policies {
+		output = append(output, storage.SignedIdentifier{
+			ID: policy.ID,
+			AccessPolicy: storage.AccessPolicyDetailsXML{
+				StartTime:  policy.Start.UTC().Round(time.Second),
+				ExpiryTime: policy.Expiry.UTC().Round(time.Second),
+				Permission: policy.Permissions,
+			},
+		})
+	}
+
+	return output, nil
+}
+
+func flattenStorageShareAccessPolicies(input []storage.SignedIdentifier) []interface{} {
+	policies := make([]storageAccessPolicy, 0, len(input))
+	for _, identifier := range input {
+		policies = append(policies, storageAccessPolicy{
+			ID:          identifier.ID,
+			Start:       identifier.AccessPolicy.StartTime,
+			Expiry:      identifier.AccessPolicy.ExpiryTime,
+			Permissions: identifier.AccessPolicy.Permission,
+		})
+	}
+
+	return flattenStorageAccessPolicies(policies)
+}
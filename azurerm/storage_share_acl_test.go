@@ -0,0 +1,82 @@
+package azurerm
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+)
+
+func TestBuildStorageShareACLStringToSign(t *testing.T) {
+	headers := map[string]string{
+		"x-ms-version":   "2016-05-31",
+		"x-ms-date":      "Mon, 01 Jan 2018 00:00:00 GMT",
+		"Content-Length": "123",
+	}
+
+	expected := strings.Join([]string{
+		"PUT",
+		"",
+		"",
+		"123",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"x-ms-date:Mon, 01 Jan 2018 00:00:00 GMT",
+		"x-ms-version:2016-05-31",
+		"/account1/share1",
+		"comp:acl",
+		"restype:share",
+	}, "\n")
+
+	actual := buildStorageShareACLStringToSign("PUT", "account1", "share1", headers)
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageShareAccessPoliciesRoundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"id":          "policy1",
+			"start":       "2018-01-01T00:00:00Z",
+			"expiry":      "2028-01-01T00:00:00Z",
+			"permissions": "rcwdl",
+		},
+	}
+
+	identifiers, err := expandStorageShareAccessPolicies(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	body, err := xml.Marshal(storage.SignedIdentifiers{SignedIdentifiers: identifiers})
+	if err != nil {
+		t.Fatalf("Error serializing the ACL: %s", err)
+	}
+
+	// the API returns times with fractional seconds
+	returned := strings.Replace(string(body), "T00:00:00Z", "T00:00:00.0000000Z", -1)
+
+	var parsed storage.SignedIdentifiers
+	if err := xml.Unmarshal([]byte(returned), &parsed); err != nil {
+		t.Fatalf("Error deserializing the ACL: %s", err)
+	}
+
+	if expected := time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC); !parsed.SignedIdentifiers[0].AccessPolicy.ExpiryTime.Equal(expected) {
+		t.Fatalf("Expected the expiry to be %s but got %s", expected, parsed.SignedIdentifiers[0].AccessPolicy.ExpiryTime)
+	}
+
+	actual := flattenStorageShareAccessPolicies(parsed.SignedIdentifiers)
+	if !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
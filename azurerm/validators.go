@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
@@ -46,3 +47,10 @@ func validateUUID(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validateRegex(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is an invalid regular expression: %+v", k, err))
+	}
+	return
+}
@@ -85,3 +85,35 @@ func TestValidateIntInSlice(t *testing.T) {
 	}
 
 }
+
+func TestValidateRegex(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			Value:    "^app-[0-9]{8}$",
+			ErrCount: 0,
+		},
+		{
+			Value:    "app-(",
+			ErrCount: 1,
+		},
+		{
+			Value:    "[",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateRegex(tc.Value, "name_regex")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected validateRegex to trigger '%d' errors for '%s' - got '%d'", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}